@@ -1,12 +1,20 @@
 package main
 
 import (
+    "aws-security-scanner/models"
     "aws-security-scanner/reporter"
     "aws-security-scanner/scanner"
+    "aws-security-scanner/scanner/policy"
+    "aws-security-scanner/store"
+    "context"
     "flag"
     "fmt"
     "os"
-    
+    "os/signal"
+    "strings"
+    "syscall"
+
+    "github.com/aws/aws-sdk-go-v2/aws"
     "github.com/fatih/color"
 )
 
@@ -28,74 +36,289 @@ func main() {
     // Parse command-line flags
     // Java: Using @CommandLineRunner or Apache Commons CLI
     region := flag.String("region", "us-east-1", "AWS region to scan")
-    output := flag.String("output", "console", "Output format: console, table, json")
-    jsonFile := flag.String("file", "", "Output JSON file (when output=json)")
+    output := flag.String("output", "console", "Output format: console, table, json, asff, sarif")
+    jsonFile := flag.String("file", "", "Output file (when output=json, output=asff or output=sarif)")
     concurrent := flag.Bool("concurrent", false, "Run scans concurrently")
-    
+    securityHub := flag.Bool("securityhub", false, "Push findings to AWS Security Hub (ASFF format)")
+    checksFlag := flag.String("checks", "", "Comma-separated list of check IDs to run (default: all), e.g. CIS-1.4,CIS-2.1")
+    skipChecksFlag := flag.String("skip-checks", "", "Comma-separated list of check IDs to skip")
+    accountsFile := flag.String("accounts-file", "", "YAML file listing accounts/regions to scan (enables multi-account mode)")
+    maxConcurrency := flag.Int("max-concurrency", 0, "Worker pool size for --concurrent and --accounts-file scans (default: runtime.NumCPU())")
+    policyDir := flag.String("policy-dir", "", "Directory of custom .rego policy files to evaluate alongside the built-in checks")
+    baseline := flag.String("baseline", "", "bbolt database file to diff this scan against (enables NEW/RESOLVED/UNCHANGED tracking)")
+    diffOnly := flag.Bool("diff-only", false, "With --baseline, only report NEW and RESOLVED findings")
+    suppressFile := flag.String("suppress-file", "", "YAML file of accepted-risk finding fingerprints to hide from the report")
+
     flag.Parse()
-    
+
     // Print banner
     printBanner()
-    
+
+    // Cancel in-flight API calls on Ctrl-C/SIGTERM instead of leaving the
+    // process to be killed mid-scan.
+    ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+    defer stop()
+
+    checks := splitChecks(*checksFlag)
+    skipChecks := splitChecks(*skipChecksFlag)
+
+    // --accounts-file scans many accounts/regions and has no single AWS
+    // config, so ASFF export / Security Hub push (which need one) aren't
+    // available in this mode.
+    if *accountsFile != "" {
+        findings, err := runMultiAccountScan(ctx, *accountsFile, *maxConcurrency, checks, skipChecks)
+        if err != nil {
+            color.Red("❌ Multi-account scan failed: %v", err)
+            os.Exit(1)
+        }
+
+        findings, err = postProcessFindings(findings, *baseline, *suppressFile, *diffOnly)
+        if err != nil {
+            color.Red("❌ Error post-processing findings: %v", err)
+            os.Exit(1)
+        }
+
+        if err := printReport(findings, *output, *jsonFile); err != nil {
+            color.Red("❌ Error generating report: %v", err)
+            os.Exit(1)
+        }
+
+        os.Exit(exitCode(findings))
+    }
+
     // Create scanner
-    s, err := scanner.NewScanner(*region)
+    s, err := scanner.NewScanner(ctx, *region)
     if err != nil {
         color.Red("❌ Error creating scanner: %v", err)
         os.Exit(1)
     }
-    
+
     // Run scan
     if *concurrent {
-        err = s.ScanConcurrent()
+        err = s.ScanConcurrent(ctx, checks, skipChecks, *maxConcurrency)
     } else {
-        err = s.Scan()
+        err = s.Scan(ctx, checks, skipChecks)
     }
-    
+
     if err != nil {
         color.Red("❌ Scan failed: %v", err)
         os.Exit(1)
     }
-    
+
     // Get findings
     findings := s.GetFindings()
-    
-    // Generate report
+
+    if *policyDir != "" {
+        policyFindings, err := runPolicyChecks(ctx, *policyDir, s.GetConfig())
+        if err != nil {
+            color.Red("❌ Policy evaluation failed: %v", err)
+            os.Exit(1)
+        }
+        findings = append(findings, policyFindings...)
+    }
+
+    findings, err = postProcessFindings(findings, *baseline, *suppressFile, *diffOnly)
+    if err != nil {
+        color.Red("❌ Error post-processing findings: %v", err)
+        os.Exit(1)
+    }
+
+    if *output == "asff" {
+        if *jsonFile == "" {
+            color.Red("❌ --file is required when output=asff")
+            os.Exit(1)
+        }
+
+        asffRep, err := reporter.NewASFFReporter(ctx, findings, s.GetConfig(), *region)
+        if err != nil {
+            color.Red("❌ Error creating ASFF reporter: %v", err)
+            os.Exit(1)
+        }
+
+        if err := asffRep.SaveASFF(*jsonFile); err != nil {
+            color.Red("❌ Error saving ASFF report: %v", err)
+            os.Exit(1)
+        }
+        color.Green("✅ ASFF report saved to: %s", *jsonFile)
+    } else if err := printReport(findings, *output, *jsonFile); err != nil {
+        color.Red("❌ Error generating report: %v", err)
+        os.Exit(1)
+    }
+
+    // Push to Security Hub if requested
+    if *securityHub {
+        asffRep, err := reporter.NewASFFReporter(ctx, findings, s.GetConfig(), *region)
+        if err != nil {
+            color.Red("❌ Error creating ASFF reporter: %v", err)
+            os.Exit(1)
+        }
+
+        if err := asffRep.PushToSecurityHub(ctx); err != nil {
+            color.Red("❌ Error pushing findings to Security Hub: %v", err)
+            os.Exit(1)
+        }
+        color.Green("✅ Pushed %d findings to Security Hub", len(findings))
+    }
+
+    // Exit with error code if critical findings exist
+    os.Exit(exitCode(findings))
+}
+
+// runMultiAccountScan loads a --accounts-file document and runs the selected
+// checks across every configured account/region.
+func runMultiAccountScan(ctx context.Context, accountsFile string, maxConcurrency int, checks, skipChecks []string) ([]*models.Finding, error) {
+    cfg, err := scanner.LoadAccountsFile(accountsFile)
+    if err != nil {
+        return nil, err
+    }
+
+    if maxConcurrency > 0 {
+        cfg.MaxConcurrency = maxConcurrency
+    }
+
+    ms, err := scanner.NewMultiScanner(ctx, cfg)
+    if err != nil {
+        return nil, fmt.Errorf("failed to create multi-account scanner: %w", err)
+    }
+
+    fmt.Printf("🔍 Starting multi-account scan across %d account(s) and %d region(s)...\n\n",
+        len(cfg.Accounts), len(cfg.Regions))
+
+    findings, err := ms.Scan(ctx, checks, skipChecks)
+    if err != nil {
+        return findings, err
+    }
+
+    fmt.Printf("\n✅ Multi-account scan complete. Found %d issues.\n", len(findings))
+    return findings, nil
+}
+
+// runPolicyChecks loads every .rego file in policyDir and evaluates it
+// against cfg's account, converting any deny/finding rule results into
+// Findings. It's only wired into the single-account scan path, since
+// --accounts-file mode has no single aws.Config (see runMultiAccountScan).
+func runPolicyChecks(ctx context.Context, policyDir string, cfg aws.Config) ([]*models.Finding, error) {
+    policies, err := policy.LoadPolicies(policyDir)
+    if err != nil {
+        return nil, err
+    }
+
+    input, err := policy.CollectInput(ctx, cfg)
+    if err != nil {
+        return nil, fmt.Errorf("failed to collect policy input: %w", err)
+    }
+
+    fmt.Printf("📋 Evaluating %d custom policy file(s) from %s...\n", len(policies), policyDir)
+    return policy.NewEngine(policies).Evaluate(ctx, input)
+}
+
+// postProcessFindings applies --baseline diffing, --suppress-file filtering,
+// and --diff-only in that order: the baseline diff needs every finding to
+// correctly classify NEW/RESOLVED/UNCHANGED before suppressions (which only
+// affect what gets reported, not what's persisted) hide any of them.
+func postProcessFindings(findings []*models.Finding, baseline, suppressFile string, diffOnly bool) ([]*models.Finding, error) {
+    if baseline != "" {
+        st, err := store.NewStore(baseline)
+        if err != nil {
+            return nil, err
+        }
+        defer st.Close()
+
+        resolved, err := st.Diff(findings)
+        if err != nil {
+            return nil, fmt.Errorf("failed to diff against baseline: %w", err)
+        }
+        findings = append(findings, resolved...)
+    }
+
+    if suppressFile != "" {
+        suppressions, err := store.LoadSuppressions(suppressFile)
+        if err != nil {
+            return nil, err
+        }
+        findings = store.FilterSuppressed(findings, suppressions)
+    }
+
+    if diffOnly {
+        filtered := make([]*models.Finding, 0, len(findings))
+        for _, finding := range findings {
+            if finding.Status == models.StatusNew || finding.Status == models.StatusResolved {
+                filtered = append(filtered, finding)
+            }
+        }
+        findings = filtered
+    }
+
+    return findings, nil
+}
+
+// printReport renders findings in the requested output format. ASFF output
+// needs a single AWS config and is handled separately by the caller.
+func printReport(findings []*models.Finding, output, jsonFile string) error {
     rep := reporter.NewReporter(findings)
     rep.SortBySeverity()
-    
-    // Output based on format
-    switch *output {
+
+    switch output {
     case "console":
         rep.PrintConsole()
     case "table":
         rep.PrintTable()
     case "json":
-        if *jsonFile == "" {
-            // Print to stdout
+        if jsonFile == "" {
             jsonStr, err := rep.GenerateJSON()
             if err != nil {
-                color.Red("❌ Error generating JSON: %v", err)
-                os.Exit(1)
+                return fmt.Errorf("error generating JSON: %w", err)
             }
             fmt.Println(jsonStr)
         } else {
-            // Save to file
-            if err := rep.SaveJSON(*jsonFile); err != nil {
-                color.Red("❌ Error saving JSON: %v", err)
-                os.Exit(1)
+            if err := rep.SaveJSON(jsonFile); err != nil {
+                return fmt.Errorf("error saving JSON: %w", err)
             }
-            color.Green("✅ Report saved to: %s", *jsonFile)
+            color.Green("✅ Report saved to: %s", jsonFile)
+        }
+    case "sarif":
+        if jsonFile == "" {
+            return fmt.Errorf("--file is required when output=sarif")
         }
+
+        sarifRep := reporter.NewSARIFReporter(findings)
+        if err := sarifRep.SaveSARIF(jsonFile); err != nil {
+            return fmt.Errorf("error saving SARIF report: %w", err)
+        }
+        color.Green("✅ SARIF report saved to: %s", jsonFile)
     default:
-        color.Red("❌ Unknown output format: %s", *output)
-        os.Exit(1)
+        return fmt.Errorf("unknown output format: %s", output)
     }
-    
-    // Exit with error code if critical findings exist
-    critical := s.GetCriticalFindings()
-    if len(critical) > 0 {
-        os.Exit(1) // Non-zero exit for CI/CD pipelines
+
+    return nil
+}
+
+// exitCode returns a non-zero exit code when critical findings exist, for
+// CI/CD pipelines that gate on the scanner's result.
+func exitCode(findings []*models.Finding) int {
+    for _, finding := range findings {
+        // A RESOLVED finding (appended by --baseline) is no longer present.
+        if finding.Severity == models.SeverityCritical && finding.Status != models.StatusResolved {
+            return 1
+        }
+    }
+    return 0
+}
+
+// splitChecks turns a comma-separated --checks/--skip-checks flag value into
+// a slice of check IDs, ignoring an empty flag.
+func splitChecks(flagValue string) []string {
+    if flagValue == "" {
+        return nil
+    }
+
+    var ids []string
+    for _, id := range strings.Split(flagValue, ",") {
+        if trimmed := strings.TrimSpace(id); trimmed != "" {
+            ids = append(ids, trimmed)
+        }
     }
+    return ids
 }
 
 func printBanner() {
@@ -108,4 +331,4 @@ func printBanner() {
     ╚═══════════════════════════════════════════════════════╝
     `
     color.Cyan(banner)
-}
\ No newline at end of file
+}
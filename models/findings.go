@@ -1,6 +1,11 @@
 package models
 
-import "time"
+import (
+    "crypto/sha256"
+    "encoding/hex"
+    "fmt"
+    "time"
+)
 
 /*
 In Java, you'd create a POJO with getters/setters:
@@ -28,7 +33,43 @@ type Finding struct {
     Description  string    `json:"description"`
     Region       string    `json:"region"`
     Account      string    `json:"account"`
+    CheckID      string    `json:"check_id"` // e.g. "CIS-1.2" - maps the finding back to the check that produced it
     Timestamp    time.Time `json:"timestamp"`
+
+    // FindingKey disambiguates multiple findings a single check can emit for
+    // the same (Account, Region, ResourceID, CheckID) - e.g. one per Public
+    // Access Block setting, or one per open port on the same security group.
+    // A check that only ever emits one finding per resource leaves it empty.
+    // It must stay stable across runs (unlike Title/Description) so a
+    // wording change doesn't reset FirstSeen or break a suppression.
+    FindingKey string `json:"finding_key,omitempty"`
+
+    // FirstSeen/LastSeen/Status are populated by store.Store.Diff when a
+    // --baseline is configured; a standalone scan leaves them zero/"".
+    FirstSeen time.Time `json:"first_seen,omitempty"`
+    LastSeen  time.Time `json:"last_seen,omitempty"`
+    Status    string    `json:"status,omitempty"`
+}
+
+// Finding status values, set by store.Store.Diff when comparing against a
+// previous scan's baseline.
+const (
+    StatusNew       = "NEW"
+    StatusResolved  = "RESOLVED"
+    StatusUnchanged = "UNCHANGED"
+)
+
+// Fingerprint returns a stable identifier for this finding, used as its key
+// in the persistent store. It intentionally excludes Title/Description/
+// Timestamp so a check's wording can change without the finding looking
+// "new" on the next run. FindingKey is included because, unlike those
+// fields, it's part of the finding's identity: two findings from the same
+// check against the same resource with different FindingKeys (e.g. two
+// different Public Access Block settings) are independent violations, not
+// duplicates, and must not collide onto the same fingerprint.
+func (f *Finding) Fingerprint() string {
+    sum := sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%s|%s|%s", f.Account, f.Region, f.ResourceID, f.CheckID, f.FindingKey)))
+    return hex.EncodeToString(sum[:])
 }
 
 // Severity levels as constants
@@ -38,6 +79,7 @@ const (
     SeverityHigh     = "HIGH"
     SeverityMedium   = "MEDIUM"
     SeverityLow      = "LOW"
+    SeverityInfo     = "INFO"
 )
 
 // Resource types as constants
@@ -46,6 +88,9 @@ const (
     ResourceSecurityGroup = "SECURITY_GROUP"
     ResourceEBS          = "EBS_VOLUME"
     ResourceIAM          = "IAM_USER"
+    ResourceVPC          = "VPC"
+    ResourceNetworkACL   = "NETWORK_ACL"
+    ResourceAccount      = "ACCOUNT"
 )
 
 /*
@@ -87,4 +132,8 @@ func NewHighFinding(resourceID, resourceType, title, description string) *Findin
 
 func NewMediumFinding(resourceID, resourceType, title, description string) *Finding {
     return NewFinding(resourceID, resourceType, SeverityMedium, title, description)
+}
+
+func NewInfoFinding(resourceID, resourceType, title, description string) *Finding {
+    return NewFinding(resourceID, resourceType, SeverityInfo, title, description)
 }
\ No newline at end of file
@@ -0,0 +1,209 @@
+package reporter
+
+import (
+    "aws-security-scanner/models"
+    "context"
+    "crypto/sha256"
+    "encoding/hex"
+    "encoding/json"
+    "fmt"
+    "os"
+    "strings"
+    "time"
+
+    "github.com/aws/aws-sdk-go-v2/aws"
+    "github.com/aws/aws-sdk-go-v2/service/securityhub"
+    securityhubtypes "github.com/aws/aws-sdk-go-v2/service/securityhub/types"
+    "github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+/*
+ASFFReporter converts our internal findings into the AWS Security Finding
+Format (ASFF) so they can be piped into Security Hub or any other tool
+that already speaks ASFF.
+
+Java equivalent:
+@Service
+public class AsffReportGenerator {
+    public List<AwsSecurityFinding> toAsff(List<Finding> findings) { ... }
+}
+
+We reuse the securityhub SDK's own AwsSecurityFinding struct instead of
+inventing our own ASFF type, so the same value can be marshalled to JSON
+*and* sent straight to BatchImportFindings.
+*/
+
+// securityHubBatchSize is the maximum number of findings BatchImportFindings
+// accepts per call.
+const securityHubBatchSize = 100
+
+// ASFFReporter generates ASFF output and can optionally push it to Security Hub.
+type ASFFReporter struct {
+    findings  []*models.Finding
+    cfg       aws.Config
+    region    string
+    accountID string
+}
+
+// NewASFFReporter creates a new ASFFReporter and resolves the caller's AWS
+// account ID via STS GetCallerIdentity, used for findings whose Account is
+// empty (a single-account scan leaves Finding.Account unset).
+func NewASFFReporter(ctx context.Context, findings []*models.Finding, cfg aws.Config, region string) (*ASFFReporter, error) {
+    identity, err := sts.NewFromConfig(cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+    if err != nil {
+        return nil, fmt.Errorf("failed to resolve AWS account via STS: %w", err)
+    }
+
+    accountID := aws.ToString(identity.Account)
+
+    return &ASFFReporter{
+        findings:  findings,
+        cfg:       cfg,
+        region:    region,
+        accountID: accountID,
+    }, nil
+}
+
+/*
+ToASFF converts every finding into an AwsSecurityFinding record.
+
+Java equivalent:
+public List<AwsSecurityFinding> toAsff() {
+    return findings.stream().map(this::convert).collect(Collectors.toList());
+}
+*/
+
+// ToASFF converts all findings into ASFF records.
+func (r *ASFFReporter) ToASFF() []securityhubtypes.AwsSecurityFinding {
+    now := time.Now().UTC().Format(time.RFC3339)
+
+    records := make([]securityhubtypes.AwsSecurityFinding, 0, len(r.findings))
+    for _, finding := range r.findings {
+        // Account may already be set (e.g. by a multi-account scan); only
+        // fall back to the STS-resolved identity when it isn't.
+        account := finding.Account
+        if account == "" {
+            account = r.accountID
+        }
+
+        record := securityhubtypes.AwsSecurityFinding{
+            SchemaVersion: aws.String("2018-10-08"),
+            Id:            aws.String(r.findingID(finding, account)),
+            ProductArn:    aws.String(r.productARN(account)),
+            GeneratorId:   aws.String(r.generatorID(finding)),
+            AwsAccountId:  aws.String(account),
+            Types:         []string{"Software and Configuration Checks/AWS Security Best Practices"},
+            CreatedAt:     aws.String(now),
+            UpdatedAt:     aws.String(now),
+            Severity: &securityhubtypes.Severity{
+                Label: securityhubtypes.SeverityLabel(r.severityLabel(finding.Severity)),
+            },
+            Title:       aws.String(finding.Title),
+            Description: aws.String(finding.Description),
+            Resources: []securityhubtypes.Resource{
+                {
+                    Type:   aws.String(finding.ResourceType),
+                    Id:     aws.String(finding.ResourceID),
+                    Region: aws.String(r.region),
+                },
+            },
+            RecordState: securityhubtypes.RecordStateActive,
+        }
+
+        records = append(records, record)
+    }
+
+    return records
+}
+
+// findingID builds a deterministic ASFF Id so re-running a scan updates the
+// same Security Hub finding instead of creating a duplicate.
+func (r *ASFFReporter) findingID(finding *models.Finding, account string) string {
+    hash := sha256.Sum256([]byte(finding.Title))
+    titleHash := hex.EncodeToString(hash[:])[:12]
+
+    return fmt.Sprintf("arn:aws:securityhub:%s:%s:scanner/%s/%s/%s",
+        r.region, account, finding.ResourceType, finding.ResourceID, titleHash)
+}
+
+// productARN is the custom-finding ProductArn Security Hub expects for
+// findings imported by a third-party product in the caller's own account.
+func (r *ASFFReporter) productARN(account string) string {
+    return fmt.Sprintf("arn:aws:securityhub:%s:%s:product/%s/default", r.region, account, account)
+}
+
+// generatorID derives a GeneratorId from the check that produced the
+// finding, falling back to a slugified title for findings produced before
+// CheckID existed.
+func (r *ASFFReporter) generatorID(finding *models.Finding) string {
+    if finding.CheckID != "" {
+        return "aws-security-scanner/" + finding.CheckID
+    }
+    slug := strings.ToLower(strings.ReplaceAll(finding.Title, " ", "-"))
+    return "aws-security-scanner/" + slug
+}
+
+// severityLabel maps our severity strings onto ASFF's Severity.Label values.
+func (r *ASFFReporter) severityLabel(severity string) string {
+    switch severity {
+    case models.SeverityCritical:
+        return "CRITICAL"
+    case models.SeverityHigh:
+        return "HIGH"
+    case models.SeverityMedium:
+        return "MEDIUM"
+    case models.SeverityLow:
+        return "LOW"
+    default:
+        return "INFORMATIONAL"
+    }
+}
+
+// SaveASFF writes the ASFF records to a JSON file.
+func (r *ASFFReporter) SaveASFF(filename string) error {
+    jsonData, err := json.MarshalIndent(r.ToASFF(), "", "  ")
+    if err != nil {
+        return fmt.Errorf("failed to marshal ASFF findings: %w", err)
+    }
+
+    return os.WriteFile(filename, jsonData, 0644)
+}
+
+/*
+PushToSecurityHub imports findings into Security Hub, batching requests at
+the BatchImportFindings limit of 100 findings per call.
+
+Java equivalent:
+public void pushToSecurityHub(List<AwsSecurityFinding> findings) {
+    Lists.partition(findings, 100).forEach(batch ->
+        securityHub.batchImportFindings(new BatchImportFindingsRequest().withFindings(batch)));
+}
+*/
+
+// PushToSecurityHub sends findings to AWS Security Hub in batches of 100.
+func (r *ASFFReporter) PushToSecurityHub(ctx context.Context) error {
+    svc := securityhub.NewFromConfig(r.cfg)
+    records := r.ToASFF()
+
+    for start := 0; start < len(records); start += securityHubBatchSize {
+        end := start + securityHubBatchSize
+        if end > len(records) {
+            end = len(records)
+        }
+
+        batch := records[start:end]
+        output, err := svc.BatchImportFindings(ctx, &securityhub.BatchImportFindingsInput{
+            Findings: batch,
+        })
+        if err != nil {
+            return fmt.Errorf("failed to import findings %d-%d to Security Hub: %w", start, end, err)
+        }
+
+        if aws.ToInt32(output.FailedCount) > 0 {
+            return fmt.Errorf("security hub rejected %d of %d findings in batch %d-%d",
+                aws.ToInt32(output.FailedCount), len(batch), start, end)
+        }
+    }
+
+    return nil
+}
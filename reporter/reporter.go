@@ -52,45 +52,59 @@ func (r *Reporter) PrintConsole() {
         color.Green("\n✅ No security issues found!")
         return
     }
-    
+
     // Print header
     fmt.Println("\n" + color.RedString("═══════════════════════════════════════════════════════════"))
     fmt.Println(color.RedString("                    SECURITY FINDINGS"))
     fmt.Println(color.RedString("═══════════════════════════════════════════════════════════"))
-    
-    // Group by severity
+
+    // Multi-account scans group by account first so findings from different
+    // accounts don't get interleaved under the same severity header.
+    accounts := r.accounts()
+    if len(accounts) > 1 {
+        for _, account := range accounts {
+            fmt.Printf("\n%s\n", color.CyanString("Account: %s", account))
+            (&Reporter{findings: r.findingsForAccount(account)}).printBySeverity()
+        }
+    } else {
+        r.printBySeverity()
+    }
+
+    // Print summary
+    fmt.Println("\n" + color.RedString("═══════════════════════════════════════════════════════════"))
+    r.printSummary()
+}
+
+// printBySeverity prints this reporter's findings grouped by severity.
+func (r *Reporter) printBySeverity() {
     bySeverity := r.groupBySeverity()
-    
-    // Print each severity group
+
     severities := []string{
         models.SeverityCritical,
         models.SeverityHigh,
         models.SeverityMedium,
         models.SeverityLow,
+        models.SeverityInfo,
     }
-    
+
     for _, severity := range severities {
         findings := bySeverity[severity]
         if len(findings) == 0 {
             continue
         }
-        
+
         // Print severity header with color
         fmt.Printf("\n%s (%d findings)\n", r.coloredSeverity(severity), len(findings))
         fmt.Println(color.WhiteString("─────────────────────────────────────────────────────────────"))
-        
+
         // Print each finding
         for i, finding := range findings {
-            fmt.Printf("\n%d. %s\n", i+1, color.YellowString(finding.Title))
+            fmt.Printf("\n%d. %s\n", i+1, titleWithStatus(finding))
             fmt.Printf("   Resource: %s (%s)\n", finding.ResourceID, finding.ResourceType)
             fmt.Printf("   Region: %s\n", finding.Region)
             fmt.Printf("   %s\n", finding.Description)
         }
     }
-    
-    // Print summary
-    fmt.Println("\n" + color.RedString("═══════════════════════════════════════════════════════════"))
-    r.printSummary()
 }
 
 /*
@@ -105,16 +119,25 @@ func (r *Reporter) PrintTable() {
         color.Green("\n✅ No security issues found!")
         return
     }
-    
+
+    // Multi-account scans get an Account column so findings can be
+    // attributed at a glance.
+    groupByAccount := len(r.accounts()) > 1
+
+    header := []string{"Severity", "Resource Type", "Resource ID", "Title"}
+    if groupByAccount {
+        header = append([]string{"Account"}, header...)
+    }
+
     // Create table
     table := tablewriter.NewWriter(os.Stdout)
-    table.SetHeader([]string{"Severity", "Resource Type", "Resource ID", "Title"})
-    
+    table.SetHeader(header)
+
     // Configure table appearance
     table.SetBorder(true)
     table.SetRowLine(true)
     table.SetAutoWrapText(false)
-    
+
     // Add rows
     for _, finding := range r.findings {
         row := []string{
@@ -123,9 +146,12 @@ func (r *Reporter) PrintTable() {
             finding.ResourceID,
             finding.Title,
         }
+        if groupByAccount {
+            row = append([]string{finding.Account}, row...)
+        }
         table.Append(row)
     }
-    
+
     fmt.Println()
     table.Render()
     r.printSummary()
@@ -166,6 +192,35 @@ func (r *Reporter) SaveJSON(filename string) error {
 
 // Helper functions
 
+// accounts returns the distinct, non-empty account IDs present in the
+// findings, sorted for deterministic output.
+func (r *Reporter) accounts() []string {
+    seen := make(map[string]bool)
+    for _, finding := range r.findings {
+        if finding.Account != "" {
+            seen[finding.Account] = true
+        }
+    }
+
+    accounts := make([]string, 0, len(seen))
+    for account := range seen {
+        accounts = append(accounts, account)
+    }
+    sort.Strings(accounts)
+    return accounts
+}
+
+// findingsForAccount returns the subset of findings belonging to an account.
+func (r *Reporter) findingsForAccount(account string) []*models.Finding {
+    var matched []*models.Finding
+    for _, finding := range r.findings {
+        if finding.Account == account {
+            matched = append(matched, finding)
+        }
+    }
+    return matched
+}
+
 // groupBySeverity groups findings by severity level
 func (r *Reporter) groupBySeverity() map[string][]*models.Finding {
     // Create map (like HashMap in Java)
@@ -176,6 +231,7 @@ func (r *Reporter) groupBySeverity() map[string][]*models.Finding {
     groups[models.SeverityHigh] = make([]*models.Finding, 0)
     groups[models.SeverityMedium] = make([]*models.Finding, 0)
     groups[models.SeverityLow] = make([]*models.Finding, 0)
+    groups[models.SeverityInfo] = make([]*models.Finding, 0)
     
     // Group findings
     for _, finding := range r.findings {
@@ -196,11 +252,33 @@ func (r *Reporter) coloredSeverity(severity string) string {
         return color.YellowString("🟡 MEDIUM")
     case models.SeverityLow:
         return color.BlueString("🔵 LOW")
+    case models.SeverityInfo:
+        return color.WhiteString("⚪ INFO")
     default:
         return severity
     }
 }
 
+/*
+titleWithStatus renders a finding's title, tagged by its --baseline diff
+status: bold red for newly-discovered findings, green strikethrough for
+findings that were present last run but are gone now. UNCHANGED findings,
+and findings from a standalone scan (no --baseline, so no Status), print
+the plain title in yellow.
+*/
+
+// titleWithStatus returns finding's title decorated for its diff Status.
+func titleWithStatus(finding *models.Finding) string {
+    switch finding.Status {
+    case models.StatusNew:
+        return color.New(color.FgRed, color.Bold).Sprintf("[NEW] %s", finding.Title)
+    case models.StatusResolved:
+        return color.New(color.FgGreen, color.CrossedOut).Sprintf("[RESOLVED] %s", finding.Title)
+    default:
+        return color.YellowString(finding.Title)
+    }
+}
+
 // printSummary prints finding count summary
 func (r *Reporter) printSummary() {
     bySeverity := r.groupBySeverity()
@@ -211,6 +289,7 @@ func (r *Reporter) printSummary() {
     fmt.Printf("  High: %d\n", len(bySeverity[models.SeverityHigh]))
     fmt.Printf("  Medium: %d\n", len(bySeverity[models.SeverityMedium]))
     fmt.Printf("  Low: %d\n", len(bySeverity[models.SeverityLow]))
+    fmt.Printf("  Info: %d\n", len(bySeverity[models.SeverityInfo]))
     fmt.Println()
 }
 
@@ -224,6 +303,7 @@ func (r *Reporter) SortBySeverity() {
         models.SeverityHigh:     1,
         models.SeverityMedium:   2,
         models.SeverityLow:      3,
+        models.SeverityInfo:     4,
     }
     
     // Sort slice
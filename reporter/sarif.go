@@ -0,0 +1,212 @@
+package reporter
+
+import (
+    "aws-security-scanner/models"
+    "encoding/json"
+    "fmt"
+    "os"
+    "sort"
+    "strings"
+)
+
+/*
+SARIFReporter converts findings into SARIF 2.1.0 (Static Analysis Results
+Interchange Format) so they can be uploaded to GitHub code scanning, Azure
+DevOps, or any other SARIF-aware viewer.
+
+Java equivalent:
+@Service
+public class SarifReportGenerator {
+    public SarifDocument toSarif(List<Finding> findings) { ... }
+}
+*/
+
+const sarifSchema = "https://raw.githubusercontent.com/oasis-tcs/sarif-spec/master/Schemata/sarif-schema-2.1.0.json"
+const sarifVersion = "2.1.0"
+const toolName = "aws-security-scanner"
+
+// helpURIBase is where each rule's HelpURI points: this project's own docs,
+// one page per check ID. We don't have per-check docs pages checked in yet,
+// but the SARIF consumer (GitHub code scanning, etc.) still needs a stable
+// URI to link "more info" to, so we point at where that doc lives once
+// written rather than leaving HelpURI empty.
+const helpURIBase = "https://github.com/nextking12/aws-security-scanner/blob/main/docs/checks/"
+
+// sarifDocument is the SARIF log's top-level shape.
+type sarifDocument struct {
+    Schema  string     `json:"$schema"`
+    Version string     `json:"version"`
+    Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+    Tool    sarifTool     `json:"tool"`
+    Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+    Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+    Name  string      `json:"name"`
+    Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+    ID                   string          `json:"id"`
+    Name                 string          `json:"name"`
+    ShortDescription     sarifText       `json:"shortDescription"`
+    FullDescription      sarifText       `json:"fullDescription"`
+    HelpURI              string          `json:"helpUri,omitempty"`
+    DefaultConfiguration sarifRuleConfig `json:"defaultConfiguration"`
+}
+
+type sarifRuleConfig struct {
+    Level string `json:"level"`
+}
+
+type sarifText struct {
+    Text string `json:"text"`
+}
+
+type sarifResult struct {
+    RuleID    string          `json:"ruleId"`
+    Level     string          `json:"level"`
+    Message   sarifText       `json:"message"`
+    Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+    LogicalLocations []sarifLogicalLocation `json:"logicalLocations"`
+}
+
+type sarifLogicalLocation struct {
+    FullyQualifiedName string `json:"fullyQualifiedName"`
+    Kind               string `json:"kind"`
+}
+
+// SARIFReporter generates SARIF output from findings.
+type SARIFReporter struct {
+    findings []*models.Finding
+}
+
+// NewSARIFReporter creates a new SARIFReporter.
+func NewSARIFReporter(findings []*models.Finding) *SARIFReporter {
+    return &SARIFReporter{findings: findings}
+}
+
+// ToSARIF converts the findings into a single-run SARIF document.
+func (r *SARIFReporter) ToSARIF() *sarifDocument {
+    rules := r.rules()
+
+    results := make([]sarifResult, 0, len(r.findings))
+    for _, finding := range r.findings {
+        results = append(results, sarifResult{
+            RuleID: r.ruleID(finding),
+            Level:  sarifLevel(finding.Severity),
+            Message: sarifText{
+                Text: finding.Description,
+            },
+            Locations: []sarifLocation{
+                {
+                    LogicalLocations: []sarifLogicalLocation{
+                        {
+                            FullyQualifiedName: fmt.Sprintf("%s/%s", finding.ResourceType, finding.ResourceID),
+                            Kind:               "resource",
+                        },
+                    },
+                },
+            },
+        })
+    }
+
+    return &sarifDocument{
+        Schema:  sarifSchema,
+        Version: sarifVersion,
+        Runs: []sarifRun{
+            {
+                Tool: sarifTool{
+                    Driver: sarifDriver{
+                        Name:  toolName,
+                        Rules: rules,
+                    },
+                },
+                Results: results,
+            },
+        },
+    }
+}
+
+// rules builds one SARIF rule per distinct check ID seen in the findings.
+func (r *SARIFReporter) rules() []sarifRule {
+    seen := make(map[string]*models.Finding)
+    for _, finding := range r.findings {
+        id := r.ruleID(finding)
+        if _, exists := seen[id]; !exists {
+            seen[id] = finding
+        }
+    }
+
+    ids := make([]string, 0, len(seen))
+    for id := range seen {
+        ids = append(ids, id)
+    }
+    sort.Strings(ids)
+
+    rules := make([]sarifRule, 0, len(ids))
+    for _, id := range ids {
+        finding := seen[id]
+        rules = append(rules, sarifRule{
+            ID:   id,
+            Name: finding.Title,
+            ShortDescription: sarifText{
+                Text: finding.Title,
+            },
+            FullDescription: sarifText{
+                Text: finding.Description,
+            },
+            HelpURI: helpURIBase + id + ".md",
+            DefaultConfiguration: sarifRuleConfig{
+                Level: sarifLevel(finding.Severity),
+            },
+        })
+    }
+
+    return rules
+}
+
+// ruleID returns the finding's check ID, falling back to a slugified title
+// for findings produced before CheckID existed.
+func (r *SARIFReporter) ruleID(finding *models.Finding) string {
+    if finding.CheckID != "" {
+        return finding.CheckID
+    }
+    return strings.ToLower(strings.ReplaceAll(finding.Title, " ", "-"))
+}
+
+// sarifLevel maps our severity strings onto SARIF's error/warning/note levels.
+func sarifLevel(severity string) string {
+    switch severity {
+    case models.SeverityCritical, models.SeverityHigh:
+        return "error"
+    case models.SeverityMedium:
+        return "warning"
+    case models.SeverityLow:
+        return "note"
+    case models.SeverityInfo:
+        return "none"
+    default:
+        return "none"
+    }
+}
+
+// SaveSARIF writes the SARIF document to a JSON file.
+func (r *SARIFReporter) SaveSARIF(filename string) error {
+    jsonData, err := json.MarshalIndent(r.ToSARIF(), "", "  ")
+    if err != nil {
+        return fmt.Errorf("failed to marshal SARIF document: %w", err)
+    }
+
+    return os.WriteFile(filename, jsonData, 0644)
+}
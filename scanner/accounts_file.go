@@ -0,0 +1,67 @@
+package scanner
+
+import (
+    "fmt"
+    "os"
+
+    "gopkg.in/yaml.v3"
+)
+
+/*
+accountsFileYAML mirrors the --accounts-file YAML format:
+
+  max_concurrency: 5
+  regions:
+    - us-east-1
+    - us-west-2
+  accounts:
+    - account_id: "111111111111"
+      role_arn: "arn:aws:iam::111111111111:role/SecurityAudit"
+      external_id: "optional-external-id"
+*/
+
+type accountsFileYAML struct {
+    MaxConcurrency int      `yaml:"max_concurrency"`
+    Regions        []string `yaml:"regions"`
+    Accounts       []struct {
+        AccountID  string `yaml:"account_id"`
+        RoleARN    string `yaml:"role_arn"`
+        ExternalID string `yaml:"external_id"`
+    } `yaml:"accounts"`
+}
+
+// LoadAccountsFile reads a --accounts-file YAML document into a MultiConfig
+// so an entire AWS Org can be scanned from a single input file.
+func LoadAccountsFile(path string) (MultiConfig, error) {
+    data, err := os.ReadFile(path)
+    if err != nil {
+        return MultiConfig{}, fmt.Errorf("failed to read accounts file: %w", err)
+    }
+
+    var parsed accountsFileYAML
+    if err := yaml.Unmarshal(data, &parsed); err != nil {
+        return MultiConfig{}, fmt.Errorf("failed to parse accounts file: %w", err)
+    }
+
+    if len(parsed.Accounts) == 0 {
+        return MultiConfig{}, fmt.Errorf("accounts file %s declares no accounts", path)
+    }
+    if len(parsed.Regions) == 0 {
+        return MultiConfig{}, fmt.Errorf("accounts file %s declares no regions", path)
+    }
+
+    cfg := MultiConfig{
+        Regions:        parsed.Regions,
+        MaxConcurrency: parsed.MaxConcurrency,
+    }
+
+    for _, account := range parsed.Accounts {
+        cfg.Accounts = append(cfg.Accounts, AccountTarget{
+            AccountID:  account.AccountID,
+            RoleARN:    account.RoleARN,
+            ExternalID: account.ExternalID,
+        })
+    }
+
+    return cfg, nil
+}
@@ -0,0 +1,99 @@
+package scanner
+
+import (
+    "aws-security-scanner/models"
+    "context"
+
+    "github.com/aws/aws-sdk-go-v2/aws"
+)
+
+/*
+Check is a single, self-contained security check.
+
+Java equivalent:
+public interface Check {
+    String getId();
+    String getTitle();
+    String getService();
+    String getSeverity();
+    List<Finding> run(AmazonClientContext ctx) throws Exception;
+}
+
+Before this, checks were just private methods on Scanner (checkUserMFA,
+checkBucketPublicAccess, ...). Pulling them out behind an interface lets us
+register arbitrary checks - including new CIS AWS Foundations Benchmark
+controls - without Scanner knowing about each one individually.
+*/
+
+// Check is implemented by every security check the scanner can run.
+type Check interface {
+    // ID returns the check's stable identifier, e.g. "CIS-1.2".
+    ID() string
+    // Title is a short human-readable name for the check.
+    Title() string
+    // Service is the AWS service the check targets (iam, s3, ec2, ...).
+    Service() string
+    // Severity is the default severity of findings this check produces.
+    Severity() string
+    // Run executes the check against the given AWS config and returns any
+    // findings. An empty slice means the check passed. Run must respect
+    // ctx cancellation so an in-flight API call can be aborted.
+    Run(ctx context.Context, cfg aws.Config) ([]*models.Finding, error)
+}
+
+/*
+Registry holds the set of checks the scanner knows how to run.
+
+Java equivalent:
+@Component
+public class CheckRegistry {
+    private final Map<String, Check> checks = new LinkedHashMap<>();
+    public void register(Check check) { checks.put(check.getId(), check); }
+}
+*/
+
+// Registry is a collection of checks keyed by ID.
+type Registry struct {
+    checks map[string]Check
+    order  []string // preserves registration order for deterministic output
+}
+
+// NewRegistry creates an empty Registry.
+func NewRegistry() *Registry {
+    return &Registry{
+        checks: make(map[string]Check),
+    }
+}
+
+// Register adds a check to the registry.
+func (r *Registry) Register(check Check) {
+    id := check.ID()
+    if _, exists := r.checks[id]; !exists {
+        r.order = append(r.order, id)
+    }
+    r.checks[id] = check
+}
+
+// All returns every registered check, in registration order.
+func (r *Registry) All() []Check {
+    checks := make([]Check, 0, len(r.order))
+    for _, id := range r.order {
+        checks = append(checks, r.checks[id])
+    }
+    return checks
+}
+
+// defaultRegistry is the process-wide registry that checks add themselves to
+// via init(), mirroring how database/sql drivers register themselves.
+var defaultRegistry = NewRegistry()
+
+// RegisterCheck adds a check to the default registry. Checks call this from
+// an init() function in their own file.
+func RegisterCheck(check Check) {
+    defaultRegistry.Register(check)
+}
+
+// DefaultRegistry returns the process-wide check registry.
+func DefaultRegistry() *Registry {
+    return defaultRegistry
+}
@@ -0,0 +1,95 @@
+package scanner
+
+import (
+    "aws-security-scanner/models"
+    "context"
+    "fmt"
+
+    "github.com/aws/aws-sdk-go-v2/aws"
+    "github.com/aws/aws-sdk-go-v2/service/cloudtrail"
+)
+
+/*
+CloudTrail checks - CIS AWS Foundations Benchmark section 2 (Logging).
+
+CloudTrail is the account's audit log. If it isn't enabled everywhere, with
+log file validation, an attacker (or a mistake) can go unnoticed.
+*/
+
+func init() {
+    RegisterCheck(&cloudTrailEnabledCheck{})
+}
+
+// cloudTrailEnabledCheck flags accounts without a multi-region trail that is
+// actively logging with log file validation enabled (CIS-2.1 and CIS-2.2).
+type cloudTrailEnabledCheck struct{}
+
+func (c *cloudTrailEnabledCheck) ID() string      { return "CIS-2.1" }
+func (c *cloudTrailEnabledCheck) Title() string    { return "CloudTrail must be enabled, multi-region, and validate log files" }
+func (c *cloudTrailEnabledCheck) Service() string  { return "cloudtrail" }
+func (c *cloudTrailEnabledCheck) Severity() string { return models.SeverityHigh }
+
+func (c *cloudTrailEnabledCheck) Run(ctx context.Context, cfg aws.Config) ([]*models.Finding, error) {
+    svc := cloudtrail.NewFromConfig(cfg)
+
+    result, err := svc.DescribeTrails(ctx, &cloudtrail.DescribeTrailsInput{
+        IncludeShadowTrails: aws.Bool(true),
+    })
+    if err != nil {
+        return nil, fmt.Errorf("failed to describe CloudTrail trails: %w", err)
+    }
+
+    if len(result.TrailList) == 0 {
+        return []*models.Finding{
+            models.NewCriticalFinding(
+                "cloudtrail",
+                models.ResourceAccount,
+                "CloudTrail Not Configured",
+                "No CloudTrail trail exists in this account. API activity is not being audited.",
+            ),
+        }, nil
+    }
+
+    var hasActiveMultiRegionTrail bool
+    var findings []*models.Finding
+
+    for _, trail := range result.TrailList {
+        name := aws.ToString(trail.Name)
+
+        status, err := svc.GetTrailStatus(ctx, &cloudtrail.GetTrailStatusInput{
+            Name: trail.TrailARN,
+        })
+        if err != nil {
+            continue
+        }
+
+        isLogging := aws.ToBool(status.IsLogging)
+        isMultiRegion := aws.ToBool(trail.IsMultiRegionTrail)
+
+        if isLogging && isMultiRegion {
+            hasActiveMultiRegionTrail = true
+        }
+
+        if !aws.ToBool(trail.LogFileValidationEnabled) {
+            findings = append(findings, models.NewMediumFinding(
+                name,
+                models.ResourceAccount,
+                "CloudTrail Log File Validation Disabled",
+                fmt.Sprintf("Trail '%s' does not have log file validation enabled, so tampering "+
+                    "with delivered log files would go undetected.", name),
+            ))
+        }
+    }
+
+    if !hasActiveMultiRegionTrail {
+        findings = append(findings, models.NewCriticalFinding(
+            "cloudtrail",
+            models.ResourceAccount,
+            "No Active Multi-Region CloudTrail",
+            "No CloudTrail trail is both multi-region and actively logging. "+
+                "API calls made outside the configured region(s) may go unrecorded.",
+        ))
+    }
+
+    return findings, nil
+}
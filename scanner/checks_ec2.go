@@ -0,0 +1,763 @@
+package scanner
+
+import (
+    "aws-security-scanner/models"
+    "context"
+    "fmt"
+    "net"
+    "sort"
+
+    "github.com/aws/aws-sdk-go-v2/aws"
+    "github.com/aws/aws-sdk-go-v2/service/ec2"
+    ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+/*
+Security Group and VPC checks - looking for overly permissive rules.
+
+0.0.0.0/0 means "the entire internet" - that's what we're hunting for.
+*/
+
+// criticalPorts lists ports that should never be open to the whole internet.
+var criticalPorts = map[int32]string{
+    22:    "SSH",
+    3389:  "RDP",
+    5432:  "PostgreSQL",
+    3306:  "MySQL",
+    1433:  "SQL Server",
+    27017: "MongoDB",
+    6379:  "Redis",
+}
+
+// publicCIDRv4/publicCIDRv6 are the ranges treated as "the entire internet".
+// Pulled out to package-level vars, same as criticalPorts above, so a fork
+// that needs a different notion of "public" (e.g. to also flag an internal
+// supernet) can override them without touching the check logic.
+var (
+    publicCIDRv4 = "0.0.0.0/0"
+    publicCIDRv6 = "::/0"
+)
+
+// broadPeerGroupNames are security-group names that, when referenced as the
+// peer in a rule, are nearly as permissive as a raw public CIDR - most
+// commonly a VPC's auto-created "default" group, which every instance lands
+// in unless it's given an explicit security group.
+var broadPeerGroupNames = map[string]bool{
+    "default": true,
+}
+
+func init() {
+    RegisterCheck(&securityGroupOpenPortsCheck{})
+    RegisterCheck(&securityGroupEgressCheck{})
+    RegisterCheck(&securityGroupBroadPeerCheck{})
+    RegisterCheck(&defaultSecurityGroupCheck{})
+    RegisterCheck(&vpcFlowLogsCheck{})
+    RegisterCheck(&networkAclCheck{})
+}
+
+// securityGroupOpenPortsCheck flags security group ingress rules that open a
+// critical port, all ports, or all protocols, to the entire internet.
+type securityGroupOpenPortsCheck struct{}
+
+func (c *securityGroupOpenPortsCheck) ID() string       { return "CIS-4.1" }
+func (c *securityGroupOpenPortsCheck) Title() string    { return "Security groups must not allow unrestricted access to critical ports" }
+func (c *securityGroupOpenPortsCheck) Service() string  { return "ec2" }
+func (c *securityGroupOpenPortsCheck) Severity() string { return models.SeverityCritical }
+
+func (c *securityGroupOpenPortsCheck) Run(ctx context.Context, cfg aws.Config) ([]*models.Finding, error) {
+    svc := ec2.NewFromConfig(cfg)
+
+    securityGroups, err := listSecurityGroups(ctx, svc, &ec2.DescribeSecurityGroupsInput{})
+    if err != nil {
+        return nil, err
+    }
+
+    var findings []*models.Finding
+    for _, sg := range securityGroups {
+        findings = append(findings, checkSecurityGroupIngress(sg)...)
+    }
+
+    return findings, nil
+}
+
+// listSecurityGroups pages through DescribeSecurityGroups so accounts with
+// more security groups than fit in one response still get scanned in full.
+func listSecurityGroups(ctx context.Context, svc *ec2.Client, input *ec2.DescribeSecurityGroupsInput) ([]ec2types.SecurityGroup, error) {
+    var securityGroups []ec2types.SecurityGroup
+
+    paginator := ec2.NewDescribeSecurityGroupsPaginator(svc, input)
+    for paginator.HasMorePages() {
+        page, err := paginator.NextPage(ctx)
+        if err != nil {
+            return nil, fmt.Errorf("failed to describe security groups: %w", err)
+        }
+        securityGroups = append(securityGroups, page.SecurityGroups...)
+    }
+
+    return securityGroups, nil
+}
+
+// checkSecurityGroupIngress examines one security group's ingress rules for
+// exposure to the entire internet, over either IPv4 or IPv6.
+func checkSecurityGroupIngress(sg ec2types.SecurityGroup) []*models.Finding {
+    sgID := aws.ToString(sg.GroupId)
+    sgName := aws.ToString(sg.GroupName)
+
+    var findings []*models.Finding
+    reported := make(map[string]bool) // dedupes per (sgID, port-or-range) across overlapping rules
+
+    for _, permission := range sg.IpPermissions {
+        if !isPublic(permission) {
+            continue
+        }
+
+        // IpProtocol "-1" means "all protocols", and AWS leaves FromPort/
+        // ToPort nil in that case - treat it as critical regardless, before
+        // the port-range logic below ever looks at those fields.
+        if aws.ToString(permission.IpProtocol) == "-1" {
+            if reported["all-protocols"] {
+                continue
+            }
+            reported["all-protocols"] = true
+            finding := models.NewCriticalFinding(
+                sgID,
+                models.ResourceSecurityGroup,
+                "All Protocols Publicly Accessible",
+                fmt.Sprintf("Security group '%s' (%s) allows ALL protocols (IpProtocol=-1) from the "+
+                    "entire internet, regardless of port. This is a critical security risk.",
+                    sgName, sgID),
+            )
+            finding.FindingKey = "all-protocols"
+            findings = append(findings, finding)
+            continue
+        }
+
+        var fromPort, toPort int32
+        if permission.FromPort != nil {
+            fromPort = *permission.FromPort
+        }
+        if permission.ToPort != nil {
+            toPort = *permission.ToPort
+        }
+
+        findings = append(findings, publicAccessFindings(sgID, sgName, fromPort, toPort, reported)...)
+    }
+
+    return findings
+}
+
+// isPublic reports whether a permission allows traffic from the entire
+// internet, checking both the IPv4 and IPv6 CIDR lists.
+func isPublic(permission ec2types.IpPermission) bool {
+    for _, ipRange := range permission.IpRanges {
+        if aws.ToString(ipRange.CidrIp) == publicCIDRv4 {
+            return true
+        }
+    }
+    for _, ipRange := range permission.Ipv6Ranges {
+        if aws.ToString(ipRange.CidrIpv6) == publicCIDRv6 {
+            return true
+        }
+    }
+    return false
+}
+
+// publicAccessFindings builds findings for ports exposed to the internet.
+// reported dedupes across rules within the same security group so a second
+// 0.0.0.0/0 range covering the same port(s) doesn't produce a duplicate
+// finding, and so an "all ports" rule doesn't also get reported once per
+// critical port it happens to cover.
+func publicAccessFindings(sgID, sgName string, fromPort, toPort int32, reported map[string]bool) []*models.Finding {
+    var findings []*models.Finding
+
+    if fromPort == 0 && toPort == 65535 {
+        if reported["all-ports"] {
+            return nil
+        }
+        reported["all-ports"] = true
+        allPorts := models.NewCriticalFinding(
+            sgID,
+            models.ResourceSecurityGroup,
+            "All Ports Publicly Accessible",
+            fmt.Sprintf("Security group '%s' (%s) allows ALL ports from anywhere. "+
+                "This is extremely dangerous.", sgName, sgID),
+        )
+        allPorts.FindingKey = "all-ports"
+        return append(findings, allPorts)
+    }
+
+    for port, service := range criticalPorts {
+        if port < fromPort || port > toPort {
+            continue
+        }
+
+        key := fmt.Sprintf("port-%d", port)
+        if reported[key] {
+            continue
+        }
+        reported[key] = true
+
+        finding := models.NewCriticalFinding(
+            sgID,
+            models.ResourceSecurityGroup,
+            fmt.Sprintf("%s Port Publicly Accessible", service),
+            fmt.Sprintf("Security group '%s' (%s) allows %s access (port %d) "+
+                "from the entire internet (0.0.0.0/0 or ::/0). This is a critical security risk.",
+                sgName, sgID, service, port),
+        )
+        // A security group open on more than one critical port would
+        // otherwise fingerprint identically for every port past the first.
+        finding.FindingKey = key
+        findings = append(findings, finding)
+    }
+
+    return findings
+}
+
+// securityGroupEgressCheck flags egress rules that allow unrestricted
+// outbound traffic to the internet - not a way in, but a way out for data
+// exfiltration or a reverse shell to a C2 server.
+type securityGroupEgressCheck struct{}
+
+func (c *securityGroupEgressCheck) ID() string       { return "SG-EGRESS-OPEN" }
+func (c *securityGroupEgressCheck) Title() string    { return "Security groups should not allow unrestricted egress" }
+func (c *securityGroupEgressCheck) Service() string  { return "ec2" }
+func (c *securityGroupEgressCheck) Severity() string { return models.SeverityMedium }
+
+func (c *securityGroupEgressCheck) Run(ctx context.Context, cfg aws.Config) ([]*models.Finding, error) {
+    svc := ec2.NewFromConfig(cfg)
+
+    securityGroups, err := listSecurityGroups(ctx, svc, &ec2.DescribeSecurityGroupsInput{})
+    if err != nil {
+        return nil, err
+    }
+
+    var findings []*models.Finding
+    for _, sg := range securityGroups {
+        findings = append(findings, checkSecurityGroupEgress(sg)...)
+    }
+
+    return findings, nil
+}
+
+// checkSecurityGroupEgress flags egress rules that open all ports to the
+// entire internet, over either IPv4 or IPv6.
+func checkSecurityGroupEgress(sg ec2types.SecurityGroup) []*models.Finding {
+    sgID := aws.ToString(sg.GroupId)
+    sgName := aws.ToString(sg.GroupName)
+
+    var findings []*models.Finding
+    reported := false
+
+    for _, permission := range sg.IpPermissionsEgress {
+        if !isPublic(permission) {
+            continue
+        }
+
+        var fromPort, toPort int32
+        if permission.FromPort != nil {
+            fromPort = *permission.FromPort
+        }
+        if permission.ToPort != nil {
+            toPort = *permission.ToPort
+        }
+
+        allProtocols := aws.ToString(permission.IpProtocol) == "-1"
+        allPorts := fromPort == 0 && toPort == 65535
+        if !allProtocols && !allPorts {
+            continue
+        }
+
+        if reported {
+            continue
+        }
+        reported = true
+
+        findings = append(findings, models.NewMediumFinding(
+            sgID,
+            models.ResourceSecurityGroup,
+            "Unrestricted Egress to the Internet",
+            fmt.Sprintf("Security group '%s' (%s) allows outbound traffic to the entire internet "+
+                "on all ports/protocols. An instance compromised in this group could exfiltrate data "+
+                "or reach a command-and-control server unimpeded.", sgName, sgID),
+        ))
+    }
+
+    return findings
+}
+
+// securityGroupBroadPeerCheck flags rules that reference a peer - a security
+// group or a managed prefix list - broad enough to be nearly as permissive
+// as a raw public CIDR. checkSecurityGroupIngress/checkSecurityGroupEgress
+// only look at IpRanges/Ipv6Ranges, so a rule like "allow from sg-xxxx
+// (default)" sails through them untouched.
+type securityGroupBroadPeerCheck struct{}
+
+func (c *securityGroupBroadPeerCheck) ID() string       { return "SG-BROAD-PEER" }
+func (c *securityGroupBroadPeerCheck) Title() string    { return "Security group rules should not reference overly broad peers" }
+func (c *securityGroupBroadPeerCheck) Service() string  { return "ec2" }
+func (c *securityGroupBroadPeerCheck) Severity() string { return models.SeverityMedium }
+
+func (c *securityGroupBroadPeerCheck) Run(ctx context.Context, cfg aws.Config) ([]*models.Finding, error) {
+    svc := ec2.NewFromConfig(cfg)
+
+    securityGroups, err := listSecurityGroups(ctx, svc, &ec2.DescribeSecurityGroupsInput{})
+    if err != nil {
+        return nil, err
+    }
+
+    resolver := newPrefixListResolver(svc)
+
+    var findings []*models.Finding
+    for _, sg := range securityGroups {
+        sgFindings, err := checkSecurityGroupBroadPeers(ctx, sg, resolver)
+        if err != nil {
+            return nil, err
+        }
+        findings = append(findings, sgFindings...)
+    }
+
+    return findings, nil
+}
+
+// prefixListResolver resolves a managed prefix list ID to whether any of
+// its entries actually cover the entire internet, caching the result per
+// list ID so a prefix list referenced by many security groups (e.g. a
+// region's S3/DynamoDB gateway-endpoint list) is only looked up once.
+type prefixListResolver struct {
+    svc   *ec2.Client
+    cache map[string]bool
+}
+
+func newPrefixListResolver(svc *ec2.Client) *prefixListResolver {
+    return &prefixListResolver{svc: svc, cache: make(map[string]bool)}
+}
+
+// isPublic reports whether prefixListID resolves to a CIDR covering the
+// entire internet, over either IPv4 or IPv6.
+func (r *prefixListResolver) isPublic(ctx context.Context, prefixListID string) (bool, error) {
+    if public, ok := r.cache[prefixListID]; ok {
+        return public, nil
+    }
+
+    var entries []ec2types.PrefixListEntry
+    paginator := ec2.NewGetManagedPrefixListEntriesPaginator(r.svc, &ec2.GetManagedPrefixListEntriesInput{
+        PrefixListId: aws.String(prefixListID),
+    })
+    for paginator.HasMorePages() {
+        page, err := paginator.NextPage(ctx)
+        if err != nil {
+            return false, fmt.Errorf("failed to get entries for prefix list %s: %w", prefixListID, err)
+        }
+        entries = append(entries, page.Entries...)
+    }
+
+    public := false
+    for _, entry := range entries {
+        if cidr := aws.ToString(entry.Cidr); cidr == publicCIDRv4 || cidr == publicCIDRv6 {
+            public = true
+            break
+        }
+    }
+
+    r.cache[prefixListID] = public
+    return public, nil
+}
+
+// checkSecurityGroupBroadPeers scans both directions of one security group
+// for rules that reference a broad peer group or a managed prefix list
+// that resolves to the entire internet.
+func checkSecurityGroupBroadPeers(ctx context.Context, sg ec2types.SecurityGroup, resolver *prefixListResolver) ([]*models.Finding, error) {
+    sgID := aws.ToString(sg.GroupId)
+    sgName := aws.ToString(sg.GroupName)
+
+    var findings []*models.Finding
+    reported := make(map[string]bool) // dedupes per (direction, peer) across rules
+
+    scan := func(permissions []ec2types.IpPermission, direction string) error {
+        for _, permission := range permissions {
+            for _, peer := range permission.UserIdGroupPairs {
+                peerName := aws.ToString(peer.GroupName)
+                if !broadPeerGroupNames[peerName] {
+                    continue
+                }
+
+                key := direction + "|group|" + aws.ToString(peer.GroupId)
+                if reported[key] {
+                    continue
+                }
+                reported[key] = true
+
+                finding := models.NewMediumFinding(
+                    sgID,
+                    models.ResourceSecurityGroup,
+                    "Security Group Rule References Broad Peer Group",
+                    fmt.Sprintf("Security group '%s' (%s) has a %s rule referencing '%s' (%s). Every "+
+                        "instance without an explicit security group lands in the default group, so "+
+                        "this is nearly as broad as opening the rule to 0.0.0.0/0.",
+                        sgName, sgID, direction, peerName, aws.ToString(peer.GroupId)),
+                )
+                // A default-SG peer can be referenced on both ingress and
+                // egress, so these need the same per-direction/peer
+                // FindingKey as the reported map already dedupes on.
+                finding.FindingKey = key
+                findings = append(findings, finding)
+            }
+
+            for _, prefixList := range permission.PrefixListIds {
+                plID := aws.ToString(prefixList.PrefixListId)
+
+                public, err := resolver.isPublic(ctx, plID)
+                if err != nil {
+                    return err
+                }
+                if !public {
+                    // Most referenced prefix lists are legitimate AWS-managed
+                    // gateway-endpoint lists (e.g. regional S3/DynamoDB)
+                    // that don't resolve to 0.0.0.0/0 - only flag the ones
+                    // that actually do.
+                    continue
+                }
+
+                key := direction + "|prefixlist|" + plID
+                if reported[key] {
+                    continue
+                }
+                reported[key] = true
+
+                finding := models.NewMediumFinding(
+                    sgID,
+                    models.ResourceSecurityGroup,
+                    "Security Group Rule References Public Managed Prefix List",
+                    fmt.Sprintf("Security group '%s' (%s) has a %s rule referencing managed prefix "+
+                        "list '%s', which resolves to a CIDR covering the entire internet - "+
+                        "effectively the same as opening the rule to 0.0.0.0/0.",
+                        sgName, sgID, direction, plID),
+                )
+                finding.FindingKey = key
+                findings = append(findings, finding)
+            }
+        }
+        return nil
+    }
+
+    if err := scan(sg.IpPermissions, "ingress"); err != nil {
+        return nil, err
+    }
+    if err := scan(sg.IpPermissionsEgress, "egress"); err != nil {
+        return nil, err
+    }
+
+    return findings, nil
+}
+
+// networkAclCheck flags VPC Network ACL entries that are as permissive as
+// the security-group checks above, modeled on the same critical-port scan
+// plus a check for allow rules that accidentally shadow a deny rule.
+type networkAclCheck struct{}
+
+func (c *networkAclCheck) ID() string       { return "NACL-OPEN" }
+func (c *networkAclCheck) Title() string    { return "Network ACLs must not allow unrestricted access to critical ports" }
+func (c *networkAclCheck) Service() string  { return "ec2" }
+func (c *networkAclCheck) Severity() string { return models.SeverityCritical }
+
+func (c *networkAclCheck) Run(ctx context.Context, cfg aws.Config) ([]*models.Finding, error) {
+    svc := ec2.NewFromConfig(cfg)
+
+    var networkAcls []ec2types.NetworkAcl
+    paginator := ec2.NewDescribeNetworkAclsPaginator(svc, &ec2.DescribeNetworkAclsInput{})
+    for paginator.HasMorePages() {
+        page, err := paginator.NextPage(ctx)
+        if err != nil {
+            return nil, fmt.Errorf("failed to describe network ACLs: %w", err)
+        }
+        networkAcls = append(networkAcls, page.NetworkAcls...)
+    }
+
+    var findings []*models.Finding
+    for _, acl := range networkAcls {
+        findings = append(findings, checkNetworkAclEntries(acl)...)
+    }
+
+    return findings, nil
+}
+
+// checkNetworkAclEntries examines one Network ACL's inbound entries. Unlike
+// a security group, a NACL is stateless and evaluated in RuleNumber order
+// with first-match-wins semantics, so entries must be sorted before either
+// check below can reason about what actually takes effect.
+func checkNetworkAclEntries(acl ec2types.NetworkAcl) []*models.Finding {
+    aclID := aws.ToString(acl.NetworkAclId)
+    vpcID := aws.ToString(acl.VpcId)
+
+    var ingress []ec2types.NetworkAclEntry
+    for _, entry := range acl.Entries {
+        if !aws.ToBool(entry.Egress) {
+            ingress = append(ingress, entry)
+        }
+    }
+    sort.Slice(ingress, func(i, j int) bool {
+        return aws.ToInt32(ingress[i].RuleNumber) < aws.ToInt32(ingress[j].RuleNumber)
+    })
+
+    var findings []*models.Finding
+    reported := make(map[string]bool)
+
+    for _, entry := range ingress {
+        if entry.RuleAction != ec2types.RuleActionAllow || !isPublicNaclEntry(entry) {
+            continue
+        }
+        if !naclProtocolHasPorts(entry.Protocol) {
+            continue
+        }
+
+        from, to := naclPortRange(entry.PortRange)
+        for port, service := range criticalPorts {
+            if port < from || port > to {
+                continue
+            }
+
+            key := fmt.Sprintf("port-%d", port)
+            if reported[key] {
+                continue
+            }
+            reported[key] = true
+
+            finding := models.NewCriticalFinding(
+                aclID,
+                models.ResourceNetworkACL,
+                fmt.Sprintf("%s Port Publicly Accessible via Network ACL", service),
+                fmt.Sprintf("Network ACL '%s' (VPC %s) rule #%d allows %s access (port %d) from the "+
+                    "entire internet. This is a critical security risk.",
+                    aclID, vpcID, aws.ToInt32(entry.RuleNumber), service, port),
+            )
+            // A single "allow all ports" entry matches every criticalPorts
+            // entry, so without a FindingKey these would all fingerprint
+            // identically and collide in the baseline store.
+            finding.FindingKey = key
+            findings = append(findings, finding)
+        }
+    }
+
+    findings = append(findings, checkNetworkAclShadowedDenies(aclID, vpcID, ingress)...)
+
+    return findings
+}
+
+// checkNetworkAclShadowedDenies flags an allow rule that is evaluated before
+// a deny rule whose CIDR and port range it overlaps - since NACLs stop at the
+// first matching rule, traffic that matches both rules gets in and the deny
+// never applies to it. Protocols are not compared, and a partial overlap is
+// flagged the same as a full one.
+func checkNetworkAclShadowedDenies(aclID, vpcID string, ingress []ec2types.NetworkAclEntry) []*models.Finding {
+    var findings []*models.Finding
+    reported := make(map[string]bool)
+
+    for i, denyEntry := range ingress {
+        if denyEntry.RuleAction != ec2types.RuleActionDeny {
+            continue
+        }
+        denyFrom, denyTo := naclPortRange(denyEntry.PortRange)
+
+        for j := 0; j < i; j++ {
+            allowEntry := ingress[j]
+            if allowEntry.RuleAction != ec2types.RuleActionAllow {
+                continue
+            }
+
+            allowFrom, allowTo := naclPortRange(allowEntry.PortRange)
+            if allowFrom > denyTo || allowTo < denyFrom {
+                continue
+            }
+            if !naclCidrsOverlap(allowEntry, denyEntry) {
+                continue
+            }
+
+            key := fmt.Sprintf("shadow-%d-%d", aws.ToInt32(allowEntry.RuleNumber), aws.ToInt32(denyEntry.RuleNumber))
+            if reported[key] {
+                continue
+            }
+            reported[key] = true
+
+            finding := models.NewHighFinding(
+                aclID,
+                models.ResourceNetworkACL,
+                "Network ACL Allow Rule Shadows a Deny Rule",
+                fmt.Sprintf("Network ACL '%s' (VPC %s) rule #%d (allow) is evaluated before rule #%d "+
+                    "(deny) and overlaps its CIDR and port range, so traffic matching both is allowed before the deny is reached. "+
+                    "NACL rules are first-match-wins in RuleNumber order - renumber so the deny comes first.",
+                    aclID, vpcID, aws.ToInt32(allowEntry.RuleNumber), aws.ToInt32(denyEntry.RuleNumber)),
+            )
+            // A NACL can shadow more than one deny rule (or the same pair
+            // via multiple overlapping allows), so these need the same
+            // per-violation FindingKey treatment as the port findings above.
+            finding.FindingKey = key
+            findings = append(findings, finding)
+            break
+        }
+    }
+
+    return findings
+}
+
+// isPublicNaclEntry reports whether a NACL entry's CIDR is the entire
+// internet, over either IPv4 or IPv6.
+func isPublicNaclEntry(entry ec2types.NetworkAclEntry) bool {
+    return aws.ToString(entry.CidrBlock) == publicCIDRv4 || aws.ToString(entry.Ipv6CidrBlock) == publicCIDRv6
+}
+
+// naclProtocolHasPorts reports whether a NACL entry's protocol number
+// (EC2's string encoding of the IANA protocol number, e.g. "6" for TCP)
+// is one where a nil PortRange meaningfully maps to "all ports" in the
+// criticalPorts sense. An ICMP-only ("1"/"58") or other non-TCP/UDP rule
+// has no ports at all, so treating its nil PortRange as 0-65535 would
+// falsely flag it against every entry in criticalPorts.
+func naclProtocolHasPorts(protocol *string) bool {
+    switch aws.ToString(protocol) {
+    case "6", "17", "-1":
+        return true
+    default:
+        return false
+    }
+}
+
+// naclPortRange returns an entry's port bounds. A nil PortRange means "all
+// ports" in a NACL entry, unlike a security group where ports are only
+// meaningful for TCP/UDP protocols.
+func naclPortRange(pr *ec2types.PortRange) (int32, int32) {
+    if pr == nil {
+        return 0, 65535
+    }
+
+    from, to := int32(0), int32(65535)
+    if pr.From != nil {
+        from = *pr.From
+    }
+    if pr.To != nil {
+        to = *pr.To
+    }
+    return from, to
+}
+
+// naclCidrsOverlap reports whether two NACL entries' CIDRs overlap, i.e.
+// either one contains the other or they're identical. Used to tell whether
+// an earlier allow rule covers any of the traffic a later deny rule
+// matches.
+func naclCidrsOverlap(a, b ec2types.NetworkAclEntry) bool {
+    aCidr := naclEntryCidr(a)
+    bCidr := naclEntryCidr(b)
+    if aCidr == "" || bCidr == "" {
+        return false
+    }
+    if aCidr == bCidr {
+        return true
+    }
+
+    _, aNet, aErr := net.ParseCIDR(aCidr)
+    _, bNet, bErr := net.ParseCIDR(bCidr)
+    if aErr != nil || bErr != nil {
+        return false
+    }
+
+    aIP, _, _ := net.ParseCIDR(aCidr)
+    bIP, _, _ := net.ParseCIDR(bCidr)
+    return aNet.Contains(bIP) || bNet.Contains(aIP)
+}
+
+// naclEntryCidr returns whichever of CidrBlock/Ipv6CidrBlock is set on an
+// entry; AWS populates exactly one of the two.
+func naclEntryCidr(entry ec2types.NetworkAclEntry) string {
+    if cidr := aws.ToString(entry.CidrBlock); cidr != "" {
+        return cidr
+    }
+    return aws.ToString(entry.Ipv6CidrBlock)
+}
+
+// defaultSecurityGroupCheck flags default security groups that don't
+// restrict all traffic (CIS-4.3).
+type defaultSecurityGroupCheck struct{}
+
+func (c *defaultSecurityGroupCheck) ID() string       { return "CIS-4.3" }
+func (c *defaultSecurityGroupCheck) Title() string    { return "Default security groups must restrict all traffic" }
+func (c *defaultSecurityGroupCheck) Service() string  { return "ec2" }
+func (c *defaultSecurityGroupCheck) Severity() string { return models.SeverityMedium }
+
+func (c *defaultSecurityGroupCheck) Run(ctx context.Context, cfg aws.Config) ([]*models.Finding, error) {
+    svc := ec2.NewFromConfig(cfg)
+
+    securityGroups, err := listSecurityGroups(ctx, svc, &ec2.DescribeSecurityGroupsInput{
+        Filters: []ec2types.Filter{
+            {
+                Name:   aws.String("group-name"),
+                Values: []string{"default"},
+            },
+        },
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    var findings []*models.Finding
+    for _, sg := range securityGroups {
+        if len(sg.IpPermissions) == 0 && len(sg.IpPermissionsEgress) == 0 {
+            continue
+        }
+
+        sgID := aws.ToString(sg.GroupId)
+        findings = append(findings, models.NewMediumFinding(
+            sgID,
+            models.ResourceSecurityGroup,
+            "Default Security Group Allows Traffic",
+            fmt.Sprintf("The default security group '%s' (VPC %s) has ingress or egress rules. "+
+                "Default security groups should deny all traffic so resources must opt in explicitly.",
+                sgID, aws.ToString(sg.VpcId)),
+        ))
+    }
+
+    return findings, nil
+}
+
+// vpcFlowLogsCheck flags VPCs that have no flow logs configured (CIS-2.9).
+type vpcFlowLogsCheck struct{}
+
+func (c *vpcFlowLogsCheck) ID() string       { return "CIS-2.9" }
+func (c *vpcFlowLogsCheck) Title() string    { return "VPCs must have flow logging enabled" }
+func (c *vpcFlowLogsCheck) Service() string  { return "ec2" }
+func (c *vpcFlowLogsCheck) Severity() string { return models.SeverityMedium }
+
+func (c *vpcFlowLogsCheck) Run(ctx context.Context, cfg aws.Config) ([]*models.Finding, error) {
+    svc := ec2.NewFromConfig(cfg)
+
+    vpcs, err := svc.DescribeVpcs(ctx, &ec2.DescribeVpcsInput{})
+    if err != nil {
+        return nil, fmt.Errorf("failed to describe VPCs: %w", err)
+    }
+
+    flowLogs, err := svc.DescribeFlowLogs(ctx, &ec2.DescribeFlowLogsInput{})
+    if err != nil {
+        return nil, fmt.Errorf("failed to describe flow logs: %w", err)
+    }
+
+    vpcsWithFlowLogs := make(map[string]bool, len(flowLogs.FlowLogs))
+    for _, flowLog := range flowLogs.FlowLogs {
+        vpcsWithFlowLogs[aws.ToString(flowLog.ResourceId)] = true
+    }
+
+    var findings []*models.Finding
+    for _, vpc := range vpcs.Vpcs {
+        vpcID := aws.ToString(vpc.VpcId)
+        if vpcsWithFlowLogs[vpcID] {
+            continue
+        }
+
+        findings = append(findings, models.NewMediumFinding(
+            vpcID,
+            models.ResourceVPC,
+            "VPC Flow Logs Disabled",
+            fmt.Sprintf("VPC '%s' does not have flow logging enabled, making network "+
+                "traffic forensics and anomaly detection impossible.", vpcID),
+        ))
+    }
+
+    return findings, nil
+}
@@ -0,0 +1,429 @@
+package scanner
+
+import (
+    "aws-security-scanner/models"
+    "bytes"
+    "context"
+    "encoding/csv"
+    "fmt"
+    "time"
+
+    "github.com/aws/aws-sdk-go-v2/aws"
+    "github.com/aws/aws-sdk-go-v2/service/iam"
+    iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+/*
+IAM checks - CIS AWS Foundations Benchmark section 1 (Identity and Access
+Management) plus our own IAM hygiene checks.
+
+Multi-Factor Authentication (MFA) is critical for security. Users with
+console access should ALWAYS have MFA enabled.
+*/
+
+// unusedCredentialThreshold is the CIS-recommended age (in days) after which
+// an unused password or access key should be disabled.
+const unusedCredentialThreshold = 90 * 24 * time.Hour
+
+func init() {
+    RegisterCheck(&iamUserMFACheck{})
+    RegisterCheck(&iamAccessKeyRotationCheck{})
+    RegisterCheck(&iamUnusedCredentialsCheck{})
+    RegisterCheck(&iamRootAccessKeyCheck{})
+    RegisterCheck(&iamRootMFACheck{})
+    RegisterCheck(&iamPasswordPolicyCheck{})
+}
+
+// iamUserMFACheck flags IAM users with console access but no MFA device.
+type iamUserMFACheck struct{}
+
+func (c *iamUserMFACheck) ID() string       { return "CIS-1.2" }
+func (c *iamUserMFACheck) Title() string    { return "IAM users with console access must have MFA enabled" }
+func (c *iamUserMFACheck) Service() string  { return "iam" }
+func (c *iamUserMFACheck) Severity() string { return models.SeverityHigh }
+
+func (c *iamUserMFACheck) Run(ctx context.Context, cfg aws.Config) ([]*models.Finding, error) {
+    svc := iam.NewFromConfig(cfg)
+
+    var findings []*models.Finding
+    paginator := iam.NewListUsersPaginator(svc, &iam.ListUsersInput{})
+    for paginator.HasMorePages() {
+        page, err := paginator.NextPage(ctx)
+        if err != nil {
+            return nil, fmt.Errorf("failed to list IAM users: %w", err)
+        }
+
+        for _, user := range page.Users {
+            userName := aws.ToString(user.UserName)
+
+            // First, check if user has console access
+            _, err := svc.GetLoginProfile(ctx, &iam.GetLoginProfileInput{
+                UserName: user.UserName,
+            })
+
+            // If no login profile, user can't access console - skip MFA check
+            if err != nil {
+                continue
+            }
+
+            // User has console access - check for MFA
+            mfaResult, err := svc.ListMFADevices(ctx, &iam.ListMFADevicesInput{
+                UserName: user.UserName,
+            })
+            if err != nil {
+                continue
+            }
+
+            if len(mfaResult.MFADevices) == 0 {
+                findings = append(findings, models.NewHighFinding(
+                    userName,
+                    models.ResourceIAM,
+                    "IAM User Without MFA",
+                    fmt.Sprintf("IAM user '%s' has console access but no MFA device configured. "+
+                        "MFA provides critical additional security layer.", userName),
+                ))
+            }
+        }
+    }
+
+    return findings, nil
+}
+
+// iamAccessKeyRotationCheck flags active access keys older than 90 days.
+type iamAccessKeyRotationCheck struct{}
+
+func (c *iamAccessKeyRotationCheck) ID() string      { return "CIS-1.14" }
+func (c *iamAccessKeyRotationCheck) Title() string   { return "Access keys should be rotated every 90 days" }
+func (c *iamAccessKeyRotationCheck) Service() string { return "iam" }
+func (c *iamAccessKeyRotationCheck) Severity() string { return models.SeverityMedium }
+
+func (c *iamAccessKeyRotationCheck) Run(ctx context.Context, cfg aws.Config) ([]*models.Finding, error) {
+    svc := iam.NewFromConfig(cfg)
+
+    var findings []*models.Finding
+    userPaginator := iam.NewListUsersPaginator(svc, &iam.ListUsersInput{})
+    for userPaginator.HasMorePages() {
+        userPage, err := userPaginator.NextPage(ctx)
+        if err != nil {
+            return nil, fmt.Errorf("failed to list IAM users: %w", err)
+        }
+
+        for _, user := range userPage.Users {
+            userName := aws.ToString(user.UserName)
+
+            keyPaginator := iam.NewListAccessKeysPaginator(svc, &iam.ListAccessKeysInput{
+                UserName: user.UserName,
+            })
+            for keyPaginator.HasMorePages() {
+                keyPage, err := keyPaginator.NextPage(ctx)
+                if err != nil {
+                    break
+                }
+
+                for _, key := range keyPage.AccessKeyMetadata {
+                    if key.Status != iamtypes.StatusTypeActive || key.CreateDate == nil {
+                        continue
+                    }
+
+                    age := time.Since(*key.CreateDate)
+                    if age > unusedCredentialThreshold {
+                        finding := models.NewMediumFinding(
+                            userName,
+                            models.ResourceIAM,
+                            "IAM Access Key Not Rotated",
+                            fmt.Sprintf("Access key '%s' for user '%s' is %.0f days old. "+
+                                "Keys should be rotated every 90 days.",
+                                aws.ToString(key.AccessKeyId), userName, age.Hours()/24),
+                        )
+                        // A user can have two stale keys at once, so the
+                        // key ID disambiguates findings sharing the same
+                        // userName/CheckID.
+                        finding.FindingKey = aws.ToString(key.AccessKeyId)
+                        findings = append(findings, finding)
+                    }
+                }
+            }
+        }
+    }
+
+    return findings, nil
+}
+
+// iamUnusedCredentialsCheck flags passwords and access keys unused for more
+// than 90 days, using IAM's generated credential report (CIS-1.3).
+type iamUnusedCredentialsCheck struct{}
+
+func (c *iamUnusedCredentialsCheck) ID() string      { return "CIS-1.3" }
+func (c *iamUnusedCredentialsCheck) Title() string   { return "Credentials unused for 90 days should be disabled" }
+func (c *iamUnusedCredentialsCheck) Service() string { return "iam" }
+func (c *iamUnusedCredentialsCheck) Severity() string { return models.SeverityMedium }
+
+func (c *iamUnusedCredentialsCheck) Run(ctx context.Context, cfg aws.Config) ([]*models.Finding, error) {
+    svc := iam.NewFromConfig(cfg)
+
+    report, err := fetchCredentialReport(ctx, svc)
+    if err != nil {
+        return nil, err
+    }
+
+    rows, err := parseCredentialReport(report)
+    if err != nil {
+        return nil, fmt.Errorf("failed to parse IAM credential report: %w", err)
+    }
+
+    var findings []*models.Finding
+    for _, row := range rows {
+        userName := row["user"]
+        if userName == "<root_account>" {
+            continue // covered by iamRootAccessKeyCheck
+        }
+
+        if unused, lastUsed := row.credentialUnused("password_enabled", "password_last_used"); unused {
+            finding := models.NewMediumFinding(
+                userName,
+                models.ResourceIAM,
+                "IAM Password Unused for 90+ Days",
+                fmt.Sprintf("User '%s' has console password access but hasn't logged in since %s. "+
+                    "Unused credentials should be disabled.", userName, lastUsed),
+            )
+            finding.FindingKey = "password"
+            findings = append(findings, finding)
+        }
+
+        if unused, lastUsed := row.credentialUnused("access_key_1_active", "access_key_1_last_used_date"); unused {
+            finding := models.NewMediumFinding(
+                userName,
+                models.ResourceIAM,
+                "IAM Access Key Unused for 90+ Days",
+                fmt.Sprintf("Access key 1 for user '%s' hasn't been used since %s. "+
+                    "Unused credentials should be disabled.", userName, lastUsed),
+            )
+            // A user can have both access keys go stale at once, so these
+            // need a FindingKey distinguishing password/key-1/key-2.
+            finding.FindingKey = "access_key_1"
+            findings = append(findings, finding)
+        }
+
+        if unused, lastUsed := row.credentialUnused("access_key_2_active", "access_key_2_last_used_date"); unused {
+            finding := models.NewMediumFinding(
+                userName,
+                models.ResourceIAM,
+                "IAM Access Key Unused for 90+ Days",
+                fmt.Sprintf("Access key 2 for user '%s' hasn't been used since %s. "+
+                    "Unused credentials should be disabled.", userName, lastUsed),
+            )
+            finding.FindingKey = "access_key_2"
+            findings = append(findings, finding)
+        }
+    }
+
+    return findings, nil
+}
+
+// iamRootAccessKeyCheck flags the presence of a root account access key
+// (CIS-1.4); the root user should only ever use console access with MFA.
+type iamRootAccessKeyCheck struct{}
+
+func (c *iamRootAccessKeyCheck) ID() string       { return "CIS-1.4" }
+func (c *iamRootAccessKeyCheck) Title() string    { return "Root account must not have access keys" }
+func (c *iamRootAccessKeyCheck) Service() string  { return "iam" }
+func (c *iamRootAccessKeyCheck) Severity() string { return models.SeverityCritical }
+
+func (c *iamRootAccessKeyCheck) Run(ctx context.Context, cfg aws.Config) ([]*models.Finding, error) {
+    svc := iam.NewFromConfig(cfg)
+
+    summary, err := svc.GetAccountSummary(ctx, &iam.GetAccountSummaryInput{})
+    if err != nil {
+        return nil, fmt.Errorf("failed to get IAM account summary: %w", err)
+    }
+
+    if summary.SummaryMap["AccountAccessKeysPresent"] > 0 {
+        return []*models.Finding{
+            models.NewCriticalFinding(
+                "root",
+                models.ResourceAccount,
+                "Root Account Has Access Keys",
+                "The AWS account root user has at least one active access key. "+
+                    "Root should never be used for programmatic access - remove the key(s) immediately.",
+            ),
+        }, nil
+    }
+
+    return nil, nil
+}
+
+// iamRootMFACheck flags a root account with no MFA device enabled
+// (CIS-1.13); root credentials are the most privileged in the account, so
+// a compromised root password with no second factor is game over.
+type iamRootMFACheck struct{}
+
+func (c *iamRootMFACheck) ID() string       { return "CIS-1.13" }
+func (c *iamRootMFACheck) Title() string    { return "MFA must be enabled for the root account" }
+func (c *iamRootMFACheck) Service() string  { return "iam" }
+func (c *iamRootMFACheck) Severity() string { return models.SeverityCritical }
+
+func (c *iamRootMFACheck) Run(ctx context.Context, cfg aws.Config) ([]*models.Finding, error) {
+    svc := iam.NewFromConfig(cfg)
+
+    summary, err := svc.GetAccountSummary(ctx, &iam.GetAccountSummaryInput{})
+    if err != nil {
+        return nil, fmt.Errorf("failed to get IAM account summary: %w", err)
+    }
+
+    if summary.SummaryMap["AccountMFAEnabled"] == 0 {
+        return []*models.Finding{
+            models.NewCriticalFinding(
+                "root",
+                models.ResourceAccount,
+                "Root Account Has No MFA Enabled",
+                "The AWS account root user does not have MFA enabled. Root is the most privileged "+
+                    "identity in the account - enable MFA (ideally hardware) on it immediately.",
+            ),
+        }, nil
+    }
+
+    return nil, nil
+}
+
+// iamPasswordPolicyCheck flags a missing or weak account password policy
+// (CIS-1.5 through CIS-1.11, collapsed into a single check).
+type iamPasswordPolicyCheck struct{}
+
+func (c *iamPasswordPolicyCheck) ID() string       { return "CIS-1.5" }
+func (c *iamPasswordPolicyCheck) Title() string    { return "IAM account password policy must be strong" }
+func (c *iamPasswordPolicyCheck) Service() string  { return "iam" }
+func (c *iamPasswordPolicyCheck) Severity() string { return models.SeverityMedium }
+
+func (c *iamPasswordPolicyCheck) Run(ctx context.Context, cfg aws.Config) ([]*models.Finding, error) {
+    svc := iam.NewFromConfig(cfg)
+
+    result, err := svc.GetAccountPasswordPolicy(ctx, &iam.GetAccountPasswordPolicyInput{})
+    if err != nil {
+        // NoSuchEntity means no password policy has ever been set.
+        return []*models.Finding{
+            models.NewCriticalFinding(
+                "account-password-policy",
+                models.ResourceAccount,
+                "No IAM Password Policy Configured",
+                "This account has no IAM password policy. Configure one requiring a minimum "+
+                    "length and character complexity.",
+            ),
+        }, nil
+    }
+
+    policy := result.PasswordPolicy
+    var issues []string
+
+    if aws.ToInt32(policy.MinimumPasswordLength) < 14 {
+        issues = append(issues, "minimum length is shorter than 14 characters")
+    }
+    if !policy.RequireSymbols {
+        issues = append(issues, "symbols are not required")
+    }
+    if !policy.RequireNumbers {
+        issues = append(issues, "numbers are not required")
+    }
+    if !policy.RequireUppercaseCharacters {
+        issues = append(issues, "uppercase characters are not required")
+    }
+    if !policy.RequireLowercaseCharacters {
+        issues = append(issues, "lowercase characters are not required")
+    }
+    if aws.ToInt32(policy.MaxPasswordAge) == 0 || aws.ToInt32(policy.MaxPasswordAge) > 90 {
+        issues = append(issues, "passwords do not expire within 90 days")
+    }
+    if aws.ToInt32(policy.PasswordReusePrevention) < 24 {
+        issues = append(issues, "password reuse prevention is not set to at least 24")
+    }
+
+    if len(issues) == 0 {
+        return nil, nil
+    }
+
+    return []*models.Finding{
+        models.NewMediumFinding(
+            "account-password-policy",
+            models.ResourceAccount,
+            "Weak IAM Password Policy",
+            fmt.Sprintf("The account password policy does not meet CIS recommendations: %v", issues),
+        ),
+    }, nil
+}
+
+// fetchCredentialReport requests IAM's generated credential report, kicking
+// off generation and retrying briefly if it isn't ready yet.
+func fetchCredentialReport(ctx context.Context, svc *iam.Client) ([]byte, error) {
+    _, _ = svc.GenerateCredentialReport(ctx, &iam.GenerateCredentialReportInput{})
+
+    var lastErr error
+    for attempt := 0; attempt < 5; attempt++ {
+        result, err := svc.GetCredentialReport(ctx, &iam.GetCredentialReportInput{})
+        if err == nil {
+            return result.Content, nil
+        }
+        lastErr = err
+
+        select {
+        case <-time.After(time.Second):
+        case <-ctx.Done():
+            return nil, ctx.Err()
+        }
+    }
+
+    return nil, fmt.Errorf("failed to get IAM credential report: %w", lastErr)
+}
+
+// credentialReportRow is one parsed row of IAM's credential report CSV.
+type credentialReportRow map[string]string
+
+// credentialUnused reports whether the named credential is active/enabled
+// and its last-used column is empty or older than 90 days.
+func (r credentialReportRow) credentialUnused(enabledCol, lastUsedCol string) (bool, string) {
+    enabled := r[enabledCol]
+    if enabled != "true" {
+        return false, ""
+    }
+
+    lastUsed := r[lastUsedCol]
+    if lastUsed == "" || lastUsed == "N/A" || lastUsed == "no_information" {
+        return true, "never"
+    }
+
+    parsed, err := time.Parse(time.RFC3339, lastUsed)
+    if err != nil {
+        return false, ""
+    }
+
+    if time.Since(parsed) > unusedCredentialThreshold {
+        return true, parsed.Format("2006-01-02")
+    }
+
+    return false, ""
+}
+
+// parseCredentialReport turns the CSV credential report into a row per user,
+// keyed by column name.
+func parseCredentialReport(content []byte) ([]credentialReportRow, error) {
+    reader := csv.NewReader(bytes.NewReader(content))
+    records, err := reader.ReadAll()
+    if err != nil {
+        return nil, err
+    }
+    if len(records) < 1 {
+        return nil, nil
+    }
+
+    header := records[0]
+    rows := make([]credentialReportRow, 0, len(records)-1)
+    for _, record := range records[1:] {
+        row := make(credentialReportRow, len(header))
+        for i, col := range header {
+            if i < len(record) {
+                row[col] = record[i]
+            }
+        }
+        rows = append(rows, row)
+    }
+
+    return rows, nil
+}
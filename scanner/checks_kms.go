@@ -0,0 +1,75 @@
+package scanner
+
+import (
+    "aws-security-scanner/models"
+    "context"
+    "fmt"
+
+    "github.com/aws/aws-sdk-go-v2/aws"
+    "github.com/aws/aws-sdk-go-v2/service/kms"
+    kmstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+)
+
+/*
+KMS checks - CIS AWS Foundations Benchmark section 2 (Logging/Monitoring)
+also covers key management: customer-managed keys should rotate
+automatically so a leaked key has a limited useful life.
+*/
+
+func init() {
+    RegisterCheck(&kmsKeyRotationCheck{})
+}
+
+// kmsKeyRotationCheck flags customer-managed KMS keys without automatic
+// rotation enabled (CIS-2.8).
+type kmsKeyRotationCheck struct{}
+
+func (c *kmsKeyRotationCheck) ID() string       { return "CIS-2.8" }
+func (c *kmsKeyRotationCheck) Title() string    { return "Customer-managed KMS keys must have rotation enabled" }
+func (c *kmsKeyRotationCheck) Service() string  { return "kms" }
+func (c *kmsKeyRotationCheck) Severity() string { return models.SeverityMedium }
+
+func (c *kmsKeyRotationCheck) Run(ctx context.Context, cfg aws.Config) ([]*models.Finding, error) {
+    svc := kms.NewFromConfig(cfg)
+
+    result, err := svc.ListKeys(ctx, &kms.ListKeysInput{})
+    if err != nil {
+        return nil, fmt.Errorf("failed to list KMS keys: %w", err)
+    }
+
+    var findings []*models.Finding
+    for _, key := range result.Keys {
+        keyID := aws.ToString(key.KeyId)
+
+        metadata, err := svc.DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: key.KeyId})
+        if err != nil {
+            continue
+        }
+
+        // Only customer-managed keys are ours to rotate; AWS-managed keys
+        // rotate on their own schedule and can't be configured here.
+        if metadata.KeyMetadata.KeyManager != kmstypes.KeyManagerTypeCustomer {
+            continue
+        }
+        if metadata.KeyMetadata.KeyState != kmstypes.KeyStateEnabled {
+            continue
+        }
+
+        rotation, err := svc.GetKeyRotationStatus(ctx, &kms.GetKeyRotationStatusInput{KeyId: key.KeyId})
+        if err != nil {
+            continue
+        }
+
+        if !rotation.KeyRotationEnabled {
+            findings = append(findings, models.NewMediumFinding(
+                keyID,
+                "KMS_KEY",
+                "KMS Key Rotation Disabled",
+                fmt.Sprintf("Customer-managed KMS key '%s' does not have automatic annual "+
+                    "rotation enabled.", keyID),
+            ))
+        }
+    }
+
+    return findings, nil
+}
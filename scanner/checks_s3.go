@@ -0,0 +1,735 @@
+package scanner
+
+import (
+    "aws-security-scanner/models"
+    "context"
+    "encoding/json"
+    "errors"
+    "fmt"
+    "strings"
+
+    "github.com/aws/aws-sdk-go-v2/aws"
+    "github.com/aws/aws-sdk-go-v2/service/s3"
+    s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+    "github.com/aws/aws-sdk-go-v2/service/s3control"
+    "github.com/aws/aws-sdk-go-v2/service/sts"
+    "github.com/aws/smithy-go"
+)
+
+/*
+S3 checks - looking for common misconfigurations
+
+Java equivalent:
+@Service
+public class S3Scanner {
+    @Autowired
+    private AmazonS3 s3Client;
+
+    public List<Finding> scan() {
+        List<Bucket> buckets = s3Client.listBuckets();
+        // check each bucket...
+    }
+}
+*/
+
+func init() {
+    RegisterCheck(&s3BucketEncryptionCheck{})
+    RegisterCheck(&s3BucketPublicAccessCheck{})
+    RegisterCheck(&s3BucketVersioningCheck{})
+    RegisterCheck(&s3BucketPolicyCheck{})
+    RegisterCheck(&s3BucketPublicAccessBlockCheck{})
+    RegisterCheck(&s3AccountPublicAccessBlockCheck{})
+}
+
+// s3BucketEncryptionCheck flags buckets without server-side encryption.
+type s3BucketEncryptionCheck struct{}
+
+func (c *s3BucketEncryptionCheck) ID() string       { return "S3-ENCRYPTION" }
+func (c *s3BucketEncryptionCheck) Title() string    { return "S3 buckets must have encryption enabled" }
+func (c *s3BucketEncryptionCheck) Service() string  { return "s3" }
+func (c *s3BucketEncryptionCheck) Severity() string { return models.SeverityHigh }
+
+func (c *s3BucketEncryptionCheck) Run(ctx context.Context, cfg aws.Config) ([]*models.Finding, error) {
+    svc := s3.NewFromConfig(cfg)
+
+    buckets, err := listBuckets(ctx, svc)
+    if err != nil {
+        return nil, err
+    }
+
+    var findings []*models.Finding
+    for _, bucket := range buckets {
+        bucketName := aws.ToString(bucket.Name)
+
+        _, err := svc.GetBucketEncryption(ctx, &s3.GetBucketEncryptionInput{
+            Bucket: bucket.Name,
+        })
+
+        // If error getting encryption, bucket is not encrypted
+        if err != nil {
+            findings = append(findings, models.NewHighFinding(
+                bucketName,
+                models.ResourceS3,
+                "S3 Bucket Not Encrypted",
+                fmt.Sprintf("Bucket '%s' does not have server-side encryption enabled. "+
+                    "Data at rest is not protected.", bucketName),
+            ))
+        }
+    }
+
+    return findings, nil
+}
+
+// s3BucketPublicAccessCheck flags buckets whose ACL grants public access.
+type s3BucketPublicAccessCheck struct{}
+
+func (c *s3BucketPublicAccessCheck) ID() string       { return "S3-PUBLIC-ACCESS" }
+func (c *s3BucketPublicAccessCheck) Title() string    { return "S3 buckets must not allow public access via ACL" }
+func (c *s3BucketPublicAccessCheck) Service() string  { return "s3" }
+func (c *s3BucketPublicAccessCheck) Severity() string { return models.SeverityCritical }
+
+func (c *s3BucketPublicAccessCheck) Run(ctx context.Context, cfg aws.Config) ([]*models.Finding, error) {
+    svc := s3.NewFromConfig(cfg)
+
+    buckets, err := listBuckets(ctx, svc)
+    if err != nil {
+        return nil, err
+    }
+
+    var findings []*models.Finding
+    for _, bucket := range buckets {
+        bucketName := aws.ToString(bucket.Name)
+
+        result, err := svc.GetBucketAcl(ctx, &s3.GetBucketAclInput{
+            Bucket: bucket.Name,
+        })
+        if err != nil {
+            // Can't determine - skip
+            continue
+        }
+
+        findings = append(findings, checkBucketACL(bucketName, result)...)
+    }
+
+    return findings, nil
+}
+
+// s3AclGrant is one (grantee, permission) tuple out of a bucket ACL, reduced
+// to the handful of grantee identities a canned ACL can express.
+type s3AclGrant struct {
+    granteeKey string
+    permission string
+}
+
+// Well-known grantee keys. A cross-account canonical user or email grantee
+// doesn't get a fixed key - see otherCanonicalPrefix below.
+const (
+    granteeOwner       = "owner"
+    granteeAllUsers    = "all-users"
+    granteeAuthUsers   = "auth-users"
+    granteeLogDelivery = "log-delivery"
+
+    otherCanonicalPrefix = "other-canonical:"
+)
+
+const (
+    s3GroupAllUsers      = "http://acs.amazonaws.com/groups/global/AllUsers"
+    s3GroupAuthenticated = "http://acs.amazonaws.com/groups/global/AuthenticatedUsers"
+    s3GroupLogDelivery   = "http://acs.amazonaws.com/groups/s3/LogDelivery"
+)
+
+// cannedACLGrantSets enumerates the exact (granteeKey, permission) tuple set
+// each canned ACL resolves to, so we can work backwards from a bucket's
+// Grants to the canned ACL name it matches, if any. "private" and
+// "bucket-owner-full-control" are indistinguishable at the grant level (both
+// are just owner FULL_CONTROL), so only "private" is listed and such a grant
+// set always resolves to "private".
+var cannedACLGrantSets = []struct {
+    name   string
+    grants []s3AclGrant
+}{
+    {"private", []s3AclGrant{
+        {granteeOwner, "FULL_CONTROL"},
+    }},
+    {"bucket-owner-read", []s3AclGrant{
+        {granteeOwner, "FULL_CONTROL"},
+        {granteeOwner, "READ"},
+    }},
+    {"log-delivery-write", []s3AclGrant{
+        {granteeOwner, "FULL_CONTROL"},
+        {granteeLogDelivery, "WRITE"},
+        {granteeLogDelivery, "READ_ACP"},
+    }},
+    {"authenticated-read", []s3AclGrant{
+        {granteeOwner, "FULL_CONTROL"},
+        {granteeAuthUsers, "READ"},
+    }},
+    {"public-read", []s3AclGrant{
+        {granteeOwner, "FULL_CONTROL"},
+        {granteeAllUsers, "READ"},
+    }},
+    {"public-read-write", []s3AclGrant{
+        {granteeOwner, "FULL_CONTROL"},
+        {granteeAllUsers, "READ"},
+        {granteeAllUsers, "WRITE"},
+    }},
+}
+
+// checkBucketACL interprets a bucket's ACL grants as a canned ACL and flags
+// the ones that grant access beyond the bucket owner.
+func checkBucketACL(bucketName string, acl *s3.GetBucketAclOutput) []*models.Finding {
+    ownerID := aws.ToString(acl.Owner.ID)
+
+    var grants []s3AclGrant
+    var crossAccountIDs []string
+    for _, grant := range acl.Grants {
+        key := s3GranteeKey(ownerID, grant.Grantee)
+        if key == "" {
+            continue
+        }
+
+        grants = append(grants, s3AclGrant{granteeKey: key, permission: string(grant.Permission)})
+        if strings.HasPrefix(key, otherCanonicalPrefix) {
+            crossAccountIDs = append(crossAccountIDs, strings.TrimPrefix(key, otherCanonicalPrefix))
+        }
+    }
+
+    var findings []*models.Finding
+
+    if cannedName, ok := matchCannedACL(grants); ok {
+        switch cannedName {
+        case "public-read-write":
+            findings = append(findings, models.NewCriticalFinding(
+                bucketName,
+                models.ResourceS3,
+                "S3 Bucket Publicly Accessible",
+                fmt.Sprintf("Bucket '%s' ACL resolves to the canned ACL '%s': the entire internet can "+
+                    "both read and write objects in this bucket.", bucketName, cannedName),
+            ))
+        case "public-read", "authenticated-read":
+            findings = append(findings, models.NewHighFinding(
+                bucketName,
+                models.ResourceS3,
+                "S3 Bucket Readable Beyond the Bucket Owner",
+                fmt.Sprintf("Bucket '%s' ACL resolves to the canned ACL '%s', granting read access to "+
+                    "anyone on the internet or to any authenticated AWS user.", bucketName, cannedName),
+            ))
+        }
+    } else if len(grants) > 0 {
+        findings = append(findings, models.NewInfoFinding(
+            bucketName,
+            models.ResourceS3,
+            "S3 Bucket Has a Custom ACL",
+            fmt.Sprintf("Bucket '%s' has an ACL that doesn't match any canned ACL - worth a human "+
+                "review to confirm the custom grant set is intentional.", bucketName),
+        ))
+    }
+
+    for _, granteeID := range dedupeStrings(crossAccountIDs) {
+        finding := models.NewMediumFinding(
+            bucketName,
+            models.ResourceS3,
+            "S3 Bucket ACL Grants Cross-Account Access",
+            fmt.Sprintf("Bucket '%s' ACL grants access to canonical user '%s', which is not the "+
+                "bucket owner. Confirm this cross-account grant is still needed.", bucketName, granteeID),
+        )
+        // A bucket can grant access to more than one external canonical
+        // ID, so these need a FindingKey to avoid colliding on bucketName/
+        // CheckID in the baseline store.
+        finding.FindingKey = granteeID
+        findings = append(findings, finding)
+    }
+
+    return findings
+}
+
+// s3GranteeKey reduces an ACL grantee to the identity a canned ACL can
+// express: the bucket owner, one of the three well-known groups, or an
+// "other-canonical:<id>" for any other canonical user/email grantee
+// (cross-account access a canned ACL never grants).
+func s3GranteeKey(ownerID string, grantee *s3types.Grantee) string {
+    if grantee == nil {
+        return ""
+    }
+
+    switch grantee.Type {
+    case s3types.TypeGroup:
+        switch aws.ToString(grantee.URI) {
+        case s3GroupAllUsers:
+            return granteeAllUsers
+        case s3GroupAuthenticated:
+            return granteeAuthUsers
+        case s3GroupLogDelivery:
+            return granteeLogDelivery
+        }
+        return ""
+    case s3types.TypeCanonicalUser:
+        id := aws.ToString(grantee.ID)
+        if id == ownerID {
+            return granteeOwner
+        }
+        return otherCanonicalPrefix + id
+    case s3types.TypeAmazonCustomerByEmail:
+        return otherCanonicalPrefix + aws.ToString(grantee.EmailAddress)
+    default:
+        return ""
+    }
+}
+
+// matchCannedACL reports which canned ACL, if any, a grant set resolves to.
+// grants is compared by exact tuple-set equality (same elements, any order).
+func matchCannedACL(grants []s3AclGrant) (string, bool) {
+    for _, candidate := range cannedACLGrantSets {
+        if grantSetsEqual(grants, candidate.grants) {
+            return candidate.name, true
+        }
+    }
+    return "", false
+}
+
+// grantSetsEqual reports whether two grant sets contain the same
+// (granteeKey, permission) tuples, ignoring order and duplicates.
+func grantSetsEqual(a, b []s3AclGrant) bool {
+    setA := make(map[s3AclGrant]bool, len(a))
+    for _, g := range a {
+        setA[g] = true
+    }
+    setB := make(map[s3AclGrant]bool, len(b))
+    for _, g := range b {
+        setB[g] = true
+    }
+
+    if len(setA) != len(setB) {
+        return false
+    }
+    for g := range setA {
+        if !setB[g] {
+            return false
+        }
+    }
+    return true
+}
+
+// dedupeStrings returns the distinct values in vals, preserving first-seen
+// order.
+func dedupeStrings(vals []string) []string {
+    seen := make(map[string]bool, len(vals))
+    var out []string
+    for _, v := range vals {
+        if seen[v] {
+            continue
+        }
+        seen[v] = true
+        out = append(out, v)
+    }
+    return out
+}
+
+// s3BucketVersioningCheck flags buckets without versioning enabled.
+type s3BucketVersioningCheck struct{}
+
+func (c *s3BucketVersioningCheck) ID() string       { return "S3-VERSIONING" }
+func (c *s3BucketVersioningCheck) Title() string    { return "S3 buckets should have versioning enabled" }
+func (c *s3BucketVersioningCheck) Service() string  { return "s3" }
+func (c *s3BucketVersioningCheck) Severity() string { return models.SeverityMedium }
+
+func (c *s3BucketVersioningCheck) Run(ctx context.Context, cfg aws.Config) ([]*models.Finding, error) {
+    svc := s3.NewFromConfig(cfg)
+
+    buckets, err := listBuckets(ctx, svc)
+    if err != nil {
+        return nil, err
+    }
+
+    var findings []*models.Finding
+    for _, bucket := range buckets {
+        bucketName := aws.ToString(bucket.Name)
+
+        result, err := svc.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{
+            Bucket: bucket.Name,
+        })
+        if err != nil {
+            continue
+        }
+
+        if result.Status != s3types.BucketVersioningStatusEnabled {
+            findings = append(findings, models.NewMediumFinding(
+                bucketName,
+                models.ResourceS3,
+                "S3 Bucket Versioning Disabled",
+                fmt.Sprintf("Bucket '%s' does not have versioning enabled. "+
+                    "Cannot recover from accidental deletion or modification.", bucketName),
+            ))
+        }
+    }
+
+    return findings, nil
+}
+
+// s3BucketPolicyCheck flags bucket policy statements that grant broad or
+// unconditional public access, catching buckets that are locked down at
+// the ACL layer but still world-readable via their policy document.
+type s3BucketPolicyCheck struct{}
+
+func (c *s3BucketPolicyCheck) ID() string       { return "S3-BUCKET-POLICY" }
+func (c *s3BucketPolicyCheck) Title() string    { return "S3 bucket policies must not grant broad or public access" }
+func (c *s3BucketPolicyCheck) Service() string  { return "s3" }
+func (c *s3BucketPolicyCheck) Severity() string { return models.SeverityCritical }
+
+func (c *s3BucketPolicyCheck) Run(ctx context.Context, cfg aws.Config) ([]*models.Finding, error) {
+    svc := s3.NewFromConfig(cfg)
+
+    buckets, err := listBuckets(ctx, svc)
+    if err != nil {
+        return nil, err
+    }
+
+    var findings []*models.Finding
+    for _, bucket := range buckets {
+        bucketName := aws.ToString(bucket.Name)
+
+        result, err := svc.GetBucketPolicy(ctx, &s3.GetBucketPolicyInput{Bucket: bucket.Name})
+        if err != nil {
+            // Most buckets have no policy at all (NoSuchBucketPolicy) - that's
+            // not a finding, just nothing more to check here.
+            continue
+        }
+
+        var doc s3PolicyDocument
+        if err := json.Unmarshal([]byte(aws.ToString(result.Policy)), &doc); err != nil {
+            // Policy didn't parse as the shape we expect - skip rather than guess.
+            continue
+        }
+
+        criticalFound, highFound := false, false
+        for _, stmt := range doc.Statement {
+            if stmt.Effect != "Allow" || stmt.Condition.hasRestrictive() {
+                continue
+            }
+
+            if !criticalFound && stmt.Principal.isWildcard() {
+                findings = append(findings, models.NewCriticalFinding(
+                    bucketName,
+                    models.ResourceS3,
+                    "S3 Bucket Policy Allows Public Access",
+                    fmt.Sprintf("Bucket '%s' has a policy statement allowing '*' (anyone) access with no "+
+                        "restrictive condition. This can expose the bucket to the entire internet regardless "+
+                        "of its ACL.", bucketName),
+                ))
+                criticalFound = true
+                continue
+            }
+
+            if !highFound && stmt.grantsBroadBucketAccess(bucketName) {
+                findings = append(findings, models.NewHighFinding(
+                    bucketName,
+                    models.ResourceS3,
+                    "S3 Bucket Policy Grants Broad Access",
+                    fmt.Sprintf("Bucket '%s' has a policy statement granting broad actions (e.g. s3:*, s3:Get*, "+
+                        "s3:List*) on all objects with no restrictive condition.", bucketName),
+                ))
+                highFound = true
+            }
+        }
+    }
+
+    return findings, nil
+}
+
+// s3PolicyDocument is the subset of an S3 bucket policy JSON document this
+// check cares about.
+type s3PolicyDocument struct {
+    Statement []s3PolicyStatement `json:"Statement"`
+}
+
+type s3PolicyStatement struct {
+    Effect    string             `json:"Effect"`
+    Principal s3PolicyPrincipal  `json:"Principal"`
+    Action    s3StringOrSlice    `json:"Action"`
+    Resource  s3StringOrSlice    `json:"Resource"`
+    Condition s3PolicyConditions `json:"Condition"`
+}
+
+// restrictiveConditionKeys are condition keys that narrow an otherwise
+// public-looking statement down to something safe (a specific network,
+// VPC, AWS Org, or requiring SSE) - their presence means the statement
+// isn't actually open to the whole internet.
+var restrictiveConditionKeys = []string{
+    "aws:SourceIp",
+    "aws:SourceVpc",
+    "aws:PrincipalOrgID",
+    "s3:x-amz-server-side-encryption",
+}
+
+// grantsBroadBucketAccess reports whether stmt grants one of the broad
+// read actions (s3:*, s3:Get*, s3:List*) over every object in bucketName.
+func (stmt s3PolicyStatement) grantsBroadBucketAccess(bucketName string) bool {
+    wantResource := fmt.Sprintf("arn:aws:s3:::%s/*", bucketName)
+
+    targetsBucket := false
+    for _, resource := range stmt.Resource {
+        if resource == wantResource {
+            targetsBucket = true
+            break
+        }
+    }
+    if !targetsBucket {
+        return false
+    }
+
+    for _, action := range stmt.Action {
+        switch action {
+        case "s3:*", "s3:Get*", "s3:List*":
+            return true
+        }
+    }
+    return false
+}
+
+// s3PolicyPrincipal is a policy statement's "Principal" element, which S3
+// emits either as the literal string "*" or as an object like
+// {"AWS": "*"} / {"AWS": ["arn1", "arn2"]}.
+type s3PolicyPrincipal struct {
+    wildcard bool
+}
+
+func (p *s3PolicyPrincipal) UnmarshalJSON(data []byte) error {
+    var literal string
+    if err := json.Unmarshal(data, &literal); err == nil {
+        p.wildcard = literal == "*"
+        return nil
+    }
+
+    var byType map[string]s3StringOrSlice
+    if err := json.Unmarshal(data, &byType); err != nil {
+        return err
+    }
+    for _, principals := range byType {
+        for _, principal := range principals {
+            if principal == "*" {
+                p.wildcard = true
+            }
+        }
+    }
+    return nil
+}
+
+func (p s3PolicyPrincipal) isWildcard() bool { return p.wildcard }
+
+// s3PolicyConditions is a statement's "Condition" element: a map of
+// condition operator (e.g. "StringEquals") to a map of condition key to
+// value(s). Only the presence of known restrictive keys is checked here,
+// so the values themselves are left as raw JSON.
+type s3PolicyConditions map[string]map[string]json.RawMessage
+
+func (c s3PolicyConditions) hasRestrictive() bool {
+    for _, keys := range c {
+        for key := range keys {
+            for _, restrictive := range restrictiveConditionKeys {
+                if strings.EqualFold(key, restrictive) {
+                    return true
+                }
+            }
+        }
+    }
+    return false
+}
+
+// s3StringOrSlice unmarshals an IAM policy element that AWS may emit as
+// either a single JSON string or an array of strings (Action, Resource,
+// and the values inside Condition/Principal blocks all do this).
+type s3StringOrSlice []string
+
+func (s *s3StringOrSlice) UnmarshalJSON(data []byte) error {
+    var single string
+    if err := json.Unmarshal(data, &single); err == nil {
+        *s = []string{single}
+        return nil
+    }
+
+    var multi []string
+    if err := json.Unmarshal(data, &multi); err != nil {
+        return err
+    }
+    *s = multi
+    return nil
+}
+
+// s3BucketPublicAccessBlockCheck flags buckets that don't have all four S3
+// Public Access Block settings enabled - a layered defense that catches
+// misconfigurations the ACL and bucket policy checks above don't, since it
+// blocks public access regardless of what a future ACL/policy change does.
+type s3BucketPublicAccessBlockCheck struct{}
+
+func (c *s3BucketPublicAccessBlockCheck) ID() string { return "S3-PUBLIC-ACCESS-BLOCK" }
+func (c *s3BucketPublicAccessBlockCheck) Title() string {
+    return "S3 buckets must have all Public Access Block settings enabled"
+}
+func (c *s3BucketPublicAccessBlockCheck) Service() string  { return "s3" }
+func (c *s3BucketPublicAccessBlockCheck) Severity() string { return models.SeverityHigh }
+
+func (c *s3BucketPublicAccessBlockCheck) Run(ctx context.Context, cfg aws.Config) ([]*models.Finding, error) {
+    svc := s3.NewFromConfig(cfg)
+
+    buckets, err := listBuckets(ctx, svc)
+    if err != nil {
+        return nil, err
+    }
+
+    var findings []*models.Finding
+    for _, bucket := range buckets {
+        bucketName := aws.ToString(bucket.Name)
+
+        var flags publicAccessBlockFlags
+        result, err := svc.GetPublicAccessBlock(ctx, &s3.GetPublicAccessBlockInput{Bucket: bucket.Name})
+        switch {
+        case err == nil && result.PublicAccessBlockConfiguration != nil:
+            config := result.PublicAccessBlockConfiguration
+            flags = publicAccessBlockFlags{
+                BlockPublicAcls:       config.BlockPublicAcls,
+                IgnorePublicAcls:      config.IgnorePublicAcls,
+                BlockPublicPolicy:     config.BlockPublicPolicy,
+                RestrictPublicBuckets: config.RestrictPublicBuckets,
+            }
+        case isNoSuchPublicAccessBlock(err):
+            // No configuration at all means none of the four settings are
+            // enabled - that's a finding, not something to skip.
+        case err != nil:
+            return nil, fmt.Errorf("failed to get public access block for bucket %s: %w", bucketName, err)
+        }
+
+        findings = append(findings, publicAccessBlockFindings(
+            bucketName, models.ResourceS3, fmt.Sprintf("Bucket '%s'", bucketName), models.SeverityHigh, flags)...)
+    }
+
+    return findings, nil
+}
+
+// s3AccountPublicAccessBlockCheck flags the account-level S3 Public Access
+// Block configuration (s3control, not s3 - it applies to every bucket in
+// the account, including ones created after this scan ran).
+type s3AccountPublicAccessBlockCheck struct{}
+
+func (c *s3AccountPublicAccessBlockCheck) ID() string { return "S3-ACCOUNT-PUBLIC-ACCESS-BLOCK" }
+func (c *s3AccountPublicAccessBlockCheck) Title() string {
+    return "The account-level S3 Public Access Block must be fully enabled"
+}
+func (c *s3AccountPublicAccessBlockCheck) Service() string  { return "s3" }
+func (c *s3AccountPublicAccessBlockCheck) Severity() string { return models.SeverityCritical }
+
+func (c *s3AccountPublicAccessBlockCheck) Run(ctx context.Context, cfg aws.Config) ([]*models.Finding, error) {
+    identity, err := sts.NewFromConfig(cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+    if err != nil {
+        return nil, fmt.Errorf("failed to get caller identity: %w", err)
+    }
+    accountID := aws.ToString(identity.Account)
+
+    svc := s3control.NewFromConfig(cfg)
+    result, err := svc.GetPublicAccessBlock(ctx, &s3control.GetPublicAccessBlockInput{AccountId: identity.Account})
+    if err != nil {
+        if !isNoSuchPublicAccessBlock(err) {
+            return nil, fmt.Errorf("failed to get account-level public access block for %s: %w", accountID, err)
+        }
+        // The account has never set one up at all, which is worse than a
+        // partially-enabled one.
+        return []*models.Finding{models.NewCriticalFinding(
+            accountID,
+            models.ResourceAccount,
+            "Account-Level S3 Public Access Block Not Configured",
+            fmt.Sprintf("Account '%s' has no account-level S3 Public Access Block configuration. "+
+                "Every bucket in the account - including ones created in the future - relies solely "+
+                "on its own ACLs and bucket policy to stay private.", accountID),
+        )}, nil
+    }
+
+    config := result.PublicAccessBlockConfiguration
+    flags := publicAccessBlockFlags{
+        BlockPublicAcls:       config.BlockPublicAcls,
+        IgnorePublicAcls:      config.IgnorePublicAcls,
+        BlockPublicPolicy:     config.BlockPublicPolicy,
+        RestrictPublicBuckets: config.RestrictPublicBuckets,
+    }
+
+    return publicAccessBlockFindings(
+        accountID, models.ResourceAccount, fmt.Sprintf("Account '%s'", accountID), models.SeverityHigh, flags), nil
+}
+
+// isNoSuchPublicAccessBlock reports whether err is S3/S3 Control's
+// NoSuchPublicAccessBlockConfiguration error - the expected response when a
+// bucket or account has never had one configured. Any other error (a
+// transient failure, AccessDenied, etc.) must not be treated the same way,
+// or a scan failure would silently turn into a false "nothing enabled"
+// finding.
+func isNoSuchPublicAccessBlock(err error) bool {
+    var apiErr smithy.APIError
+    if !errors.As(err, &apiErr) {
+        return false
+    }
+    return apiErr.ErrorCode() == "NoSuchPublicAccessBlockConfiguration"
+}
+
+// publicAccessBlockFlags is the four S3 Public Access Block settings,
+// shared between the bucket-level (s3types) and account-level
+// (s3controltypes) configurations, which have identical fields but
+// different Go types.
+type publicAccessBlockFlags struct {
+    BlockPublicAcls       *bool
+    IgnorePublicAcls      *bool
+    BlockPublicPolicy     *bool
+    RestrictPublicBuckets *bool
+}
+
+// publicAccessBlockFindings returns one finding per Public Access Block
+// setting that isn't enabled.
+func publicAccessBlockFindings(resourceID, resourceType, label, severity string, flags publicAccessBlockFlags) []*models.Finding {
+    settings := []struct {
+        name    string
+        enabled bool
+    }{
+        {"BlockPublicAcls", aws.ToBool(flags.BlockPublicAcls)},
+        {"IgnorePublicAcls", aws.ToBool(flags.IgnorePublicAcls)},
+        {"BlockPublicPolicy", aws.ToBool(flags.BlockPublicPolicy)},
+        {"RestrictPublicBuckets", aws.ToBool(flags.RestrictPublicBuckets)},
+    }
+
+    var findings []*models.Finding
+    for _, setting := range settings {
+        if setting.enabled {
+            continue
+        }
+
+        finding := models.NewFinding(
+            resourceID,
+            resourceType,
+            severity,
+            fmt.Sprintf("S3 Public Access Block Setting '%s' Disabled", setting.name),
+            fmt.Sprintf("%s does not have the Public Access Block setting '%s' enabled.", label, setting.name),
+        )
+        // The four settings share a resource and check ID, so without a
+        // FindingKey they'd fingerprint identically and collide in the
+        // baseline store/suppression list the moment more than one is
+        // disabled at once - the common case.
+        finding.FindingKey = setting.name
+        findings = append(findings, finding)
+    }
+
+    return findings
+}
+
+// listBuckets lists every bucket in the account, paging through
+// ListBuckets' ContinuationToken so accounts with more than one page of
+// buckets are actually fully scanned.
+func listBuckets(ctx context.Context, svc *s3.Client) ([]s3types.Bucket, error) {
+    var buckets []s3types.Bucket
+
+    paginator := s3.NewListBucketsPaginator(svc, &s3.ListBucketsInput{})
+    for paginator.HasMorePages() {
+        page, err := paginator.NextPage(ctx)
+        if err != nil {
+            return nil, fmt.Errorf("failed to list S3 buckets: %w", err)
+        }
+        buckets = append(buckets, page.Buckets...)
+    }
+
+    return buckets, nil
+}
@@ -0,0 +1,66 @@
+package concurrency
+
+import (
+    "context"
+    "runtime"
+    "sync"
+)
+
+/*
+Package concurrency provides a small, generic bounded worker pool for
+fanning scan work out across goroutines without overwhelming whatever it's
+calling (in our case, the AWS APIs).
+
+Java equivalent:
+ExecutorService pool = Executors.newFixedThreadPool(maxConcurrency);
+List<Future<?>> futures = jobs.stream()
+    .map(job -> pool.submit(() -> fn.run(job)))
+    .collect(Collectors.toList());
+for (Future<?> f : futures) f.get(); // propagate the first failure
+*/
+
+// Job identifies one unit of scan work: a single check run against a single
+// account/region. AccountID is empty for single-account scans.
+type Job struct {
+    CheckID   string
+    Region    string
+    AccountID string
+}
+
+// ForEachJob runs fn for every job in jobs using a worker pool bounded to
+// maxConcurrency (runtime.NumCPU() if <= 0), so a large job list can't spawn
+// an unbounded number of goroutines and hammer whatever fn calls out to. It
+// waits for every job to finish and returns the first error encountered, if
+// any.
+func ForEachJob(ctx context.Context, jobs []Job, maxConcurrency int, fn func(ctx context.Context, job Job) error) error {
+    if maxConcurrency <= 0 {
+        maxConcurrency = runtime.NumCPU()
+    }
+
+    sem := make(chan struct{}, maxConcurrency)
+    var wg sync.WaitGroup
+    errChan := make(chan error, len(jobs))
+
+    for _, job := range jobs {
+        wg.Add(1)
+        sem <- struct{}{} // acquire a worker slot
+        go func(job Job) {
+            defer wg.Done()
+            defer func() { <-sem }() // release the slot
+
+            if err := fn(ctx, job); err != nil {
+                errChan <- err
+            }
+        }(job)
+    }
+
+    wg.Wait()
+    close(errChan)
+
+    for err := range errChan {
+        if err != nil {
+            return err
+        }
+    }
+    return nil
+}
@@ -0,0 +1,67 @@
+package concurrency
+
+import (
+    "context"
+    "errors"
+    "fmt"
+    "math/rand"
+    "time"
+
+    "github.com/aws/smithy-go"
+)
+
+/*
+RetryThrottled retries an AWS call with exponential backoff and jitter when
+it fails with a throttling error, so a burst of concurrent checks backs off
+instead of making throttling worse.
+
+Java equivalent: a @Retryable method scoped to a set of retryable exception
+types, backed by an ExponentialBackOffPolicy with randomized jitter.
+*/
+
+const (
+    maxRetryAttempts = 5
+    initialBackoff   = 200 * time.Millisecond
+)
+
+// RetryThrottled calls fn, retrying with exponential backoff + jitter while
+// it fails with an AWS throttling error, up to maxRetryAttempts calls in all.
+// Any other error is returned immediately without retrying.
+func RetryThrottled(ctx context.Context, fn func() error) error {
+    backoff := initialBackoff
+
+    var err error
+    for attempt := 0; attempt < maxRetryAttempts; attempt++ {
+        err = fn()
+        if err == nil {
+            return nil
+        }
+        if !isThrottlingError(err) {
+            return err
+        }
+
+        wait := backoff + time.Duration(rand.Int63n(int64(backoff)))
+        select {
+        case <-time.After(wait):
+        case <-ctx.Done():
+            return ctx.Err()
+        }
+        backoff *= 2
+    }
+
+    return fmt.Errorf("exceeded %d retry attempts: %w", maxRetryAttempts, err)
+}
+
+// isThrottlingError reports whether err is an AWS API throttling response.
+func isThrottlingError(err error) bool {
+    var apiErr smithy.APIError
+    if !errors.As(err, &apiErr) {
+        return false
+    }
+
+    switch apiErr.ErrorCode() {
+    case "RequestLimitExceeded", "Throttling", "ThrottlingException", "TooManyRequestsException":
+        return true
+    }
+    return false
+}
@@ -0,0 +1,210 @@
+package scanner
+
+import (
+    "aws-security-scanner/models"
+    "aws-security-scanner/scanner/concurrency"
+    "context"
+    "fmt"
+    "runtime"
+    "sync"
+
+    "github.com/aws/aws-sdk-go-v2/aws"
+    awsconfig "github.com/aws/aws-sdk-go-v2/config"
+    "github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+    "github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+/*
+MultiScanner fans a scan out across multiple AWS accounts (via assumed
+roles) and multiple regions, so an entire AWS Org can be scanned in one
+invocation instead of one `NewScanner(region)` at a time.
+
+Java equivalent:
+@Service
+public class MultiAccountScanner {
+    public List<Finding> scan(MultiConfig config) {
+        ExecutorService executor = Executors.newFixedThreadPool(config.getMaxConcurrency());
+        // assume each role, fan out per-region jobs, collect results
+    }
+}
+*/
+
+// globalServices lists check Service() values that only need to run once per
+// account, regardless of how many regions are configured (IAM and S3 are
+// not region-scoped the way EC2/KMS/CloudTrail resources are).
+var globalServices = map[string]bool{
+    "iam": true,
+    "s3":  true,
+}
+
+// AccountTarget is a single AWS account to scan, reached by assuming RoleARN
+// from the scanner's own credentials.
+type AccountTarget struct {
+    AccountID  string
+    RoleARN    string
+    ExternalID string
+}
+
+// MultiConfig configures a MultiScanner run.
+type MultiConfig struct {
+    Regions        []string
+    Accounts       []AccountTarget
+    MaxConcurrency int // defaults to runtime.NumCPU() if zero
+}
+
+// MultiScanner coordinates Scanner runs across accounts and regions.
+type MultiScanner struct {
+    cfg     MultiConfig
+    baseCfg aws.Config
+
+    mu       sync.Mutex
+    findings []*models.Finding
+}
+
+// NewMultiScanner creates a MultiScanner. ctx bounds the base config's
+// credential resolution, not the scan itself. The caller's own credentials
+// (the default AWS credential chain) are used to assume each AccountTarget's
+// role.
+func NewMultiScanner(ctx context.Context, cfg MultiConfig) (*MultiScanner, error) {
+    baseCfg, err := awsconfig.LoadDefaultConfig(ctx)
+    if err != nil {
+        return nil, fmt.Errorf("failed to load base AWS config: %w", err)
+    }
+
+    if cfg.MaxConcurrency <= 0 {
+        cfg.MaxConcurrency = runtime.NumCPU()
+    }
+
+    return &MultiScanner{
+        cfg:     cfg,
+        baseCfg: baseCfg,
+    }, nil
+}
+
+// sessionKey identifies one assumed-role session, scoped to a single
+// account and region.
+type sessionKey struct {
+    accountID string
+    region    string
+}
+
+/*
+Scan runs the selected checks against every configured account/region
+combination, flattened into one concurrency.Job per check run and bounded
+by cfg.MaxConcurrency through the shared worker-pool scheduler.
+
+Java equivalent:
+ExecutorService pool = Executors.newFixedThreadPool(maxConcurrency);
+jobs.forEach(job -> pool.submit(() -> runJob(job)));
+*/
+
+// Scan executes the selected checks across all configured accounts and
+// regions and returns the combined findings. ctx cancellation aborts
+// in-flight AWS API calls across every worker.
+func (ms *MultiScanner) Scan(ctx context.Context, checks, skipChecks []string) ([]*models.Finding, error) {
+    selected := selectChecks(checks, skipChecks)
+    checksByID := make(map[string]Check, len(selected))
+    for _, check := range selected {
+        checksByID[check.ID()] = check
+    }
+
+    configs, err := ms.buildConfigs(ctx)
+    if err != nil {
+        return nil, err
+    }
+
+    jobs := ms.buildJobs(selected)
+
+    err = concurrency.ForEachJob(ctx, jobs, ms.cfg.MaxConcurrency, func(ctx context.Context, job concurrency.Job) error {
+        targetCfg := configs[sessionKey{accountID: job.AccountID, region: job.Region}]
+        check := checksByID[job.CheckID]
+
+        findings, err := runCheckThrottled(ctx, check, targetCfg)
+        if err != nil {
+            return fmt.Errorf("account %s region %s check %s: %w", job.AccountID, job.Region, check.ID(), err)
+        }
+
+        for _, finding := range findings {
+            finding.CheckID = check.ID()
+            finding.Region = job.Region
+        }
+        ms.addFindings(job.AccountID, findings)
+        return nil
+    })
+
+    return ms.findings, err
+}
+
+// buildConfigs assumes every configured account's role once per region,
+// ahead of dispatching check jobs, so the concurrent jobs for a given
+// account/region share one set of credentials instead of each re-assuming
+// the role.
+func (ms *MultiScanner) buildConfigs(ctx context.Context) (map[sessionKey]aws.Config, error) {
+    configs := make(map[sessionKey]aws.Config)
+    for _, account := range ms.cfg.Accounts {
+        for _, region := range ms.cfg.Regions {
+            targetCfg, err := ms.assumeRoleConfig(ctx, account, region)
+            if err != nil {
+                return nil, fmt.Errorf("failed to assume role %s: %w", account.RoleARN, err)
+            }
+            configs[sessionKey{accountID: account.AccountID, region: region}] = targetCfg
+        }
+    }
+    return configs, nil
+}
+
+// buildJobs expands the account x region x check matrix into one job per
+// check run. Global-service checks (IAM, S3) only need one job per account,
+// so they're only scheduled for the account's first configured region.
+func (ms *MultiScanner) buildJobs(selected []Check) []concurrency.Job {
+    var jobs []concurrency.Job
+    for _, account := range ms.cfg.Accounts {
+        for _, region := range ms.cfg.Regions {
+            isFirstRegion := ms.cfg.Regions[0] == region
+            for _, check := range selected {
+                if globalServices[check.Service()] && !isFirstRegion {
+                    continue
+                }
+                jobs = append(jobs, concurrency.Job{
+                    CheckID:   check.ID(),
+                    Region:    region,
+                    AccountID: account.AccountID,
+                })
+            }
+        }
+    }
+    return jobs
+}
+
+// assumeRoleConfig builds an aws.Config for the target account/region using
+// STS AssumeRole credentials backed by our own (base) config.
+func (ms *MultiScanner) assumeRoleConfig(ctx context.Context, target AccountTarget, region string) (aws.Config, error) {
+    stsClient := sts.NewFromConfig(ms.baseCfg)
+    provider := stscreds.NewAssumeRoleProvider(stsClient, target.RoleARN, func(o *stscreds.AssumeRoleOptions) {
+        if target.ExternalID != "" {
+            o.ExternalID = aws.String(target.ExternalID)
+        }
+    })
+
+    return awsconfig.LoadDefaultConfig(ctx,
+        awsconfig.WithRegion(region),
+        awsconfig.WithCredentialsProvider(aws.NewCredentialsCache(provider)),
+    )
+}
+
+// addFindings records a job's findings, stamping the account ID onto each
+// one (Region is stamped by the caller, since there's no per-job Scanner).
+func (ms *MultiScanner) addFindings(accountID string, findings []*models.Finding) {
+    ms.mu.Lock()
+    defer ms.mu.Unlock()
+
+    for _, finding := range findings {
+        finding.Account = accountID
+        ms.findings = append(ms.findings, finding)
+    }
+}
+
+// GetFindings returns every finding collected so far.
+func (ms *MultiScanner) GetFindings() []*models.Finding {
+    return ms.findings
+}
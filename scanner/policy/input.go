@@ -0,0 +1,219 @@
+package policy
+
+import (
+    "context"
+    "fmt"
+    "time"
+
+    "github.com/aws/aws-sdk-go-v2/aws"
+    "github.com/aws/aws-sdk-go-v2/service/ec2"
+    ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+    "github.com/aws/aws-sdk-go-v2/service/iam"
+    "github.com/aws/aws-sdk-go-v2/service/s3"
+    s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+/*
+CollectInput gathers the same raw AWS resource descriptions the built-in
+checks use and normalizes them into the input document policies evaluate
+against: {"iam_users": [...], "s3_buckets": [...], "security_groups": [...]}.
+
+Each entry is a plain map so it round-trips into Rego values without any
+special handling of AWS SDK pointer fields.
+*/
+
+// CollectInput builds the policy engine's input document for cfg's account
+// and region.
+func CollectInput(ctx context.Context, cfg aws.Config) (map[string]any, error) {
+    iamUsers, err := collectIAMUsers(ctx, cfg)
+    if err != nil {
+        return nil, err
+    }
+
+    s3Buckets, err := collectS3Buckets(ctx, cfg)
+    if err != nil {
+        return nil, err
+    }
+
+    securityGroups, err := collectSecurityGroups(ctx, cfg)
+    if err != nil {
+        return nil, err
+    }
+
+    return map[string]any{
+        "iam_users":       iamUsers,
+        "s3_buckets":      s3Buckets,
+        "security_groups": securityGroups,
+    }, nil
+}
+
+// collectIAMUsers mirrors the data iamUserMFACheck/iamAccessKeyRotationCheck
+// inspect: console access, MFA devices, and active access key ages.
+func collectIAMUsers(ctx context.Context, cfg aws.Config) ([]map[string]any, error) {
+    svc := iam.NewFromConfig(cfg)
+
+    var users []map[string]any
+    paginator := iam.NewListUsersPaginator(svc, &iam.ListUsersInput{})
+    for paginator.HasMorePages() {
+        page, err := paginator.NextPage(ctx)
+        if err != nil {
+            return nil, fmt.Errorf("failed to list IAM users: %w", err)
+        }
+
+        for _, user := range page.Users {
+            userName := aws.ToString(user.UserName)
+
+            _, err := svc.GetLoginProfile(ctx, &iam.GetLoginProfileInput{UserName: user.UserName})
+            hasConsoleAccess := err == nil
+
+            mfaDeviceCount := 0
+            if mfaResult, err := svc.ListMFADevices(ctx, &iam.ListMFADevicesInput{UserName: user.UserName}); err == nil {
+                mfaDeviceCount = len(mfaResult.MFADevices)
+            }
+
+            var accessKeys []map[string]any
+            keyPaginator := iam.NewListAccessKeysPaginator(svc, &iam.ListAccessKeysInput{UserName: user.UserName})
+            for keyPaginator.HasMorePages() {
+                keyPage, err := keyPaginator.NextPage(ctx)
+                if err != nil {
+                    break
+                }
+                for _, key := range keyPage.AccessKeyMetadata {
+                    ageDays := 0.0
+                    if key.CreateDate != nil {
+                        ageDays = float64(daysSince(*key.CreateDate))
+                    }
+                    accessKeys = append(accessKeys, map[string]any{
+                        "id":       aws.ToString(key.AccessKeyId),
+                        "active":   key.Status == "Active",
+                        "age_days": ageDays,
+                    })
+                }
+            }
+
+            users = append(users, map[string]any{
+                "user_name":          userName,
+                "has_console_access": hasConsoleAccess,
+                "mfa_enabled":        mfaDeviceCount > 0,
+                "access_keys":        accessKeys,
+            })
+        }
+    }
+
+    return users, nil
+}
+
+// collectS3Buckets mirrors the data the s3Bucket* checks inspect:
+// encryption, public ACL grants, and versioning.
+func collectS3Buckets(ctx context.Context, cfg aws.Config) ([]map[string]any, error) {
+    svc := s3.NewFromConfig(cfg)
+
+    buckets, err := listBuckets(ctx, svc)
+    if err != nil {
+        return nil, err
+    }
+
+    var result []map[string]any
+    for _, bucket := range buckets {
+        bucketName := aws.ToString(bucket.Name)
+
+        _, err := svc.GetBucketEncryption(ctx, &s3.GetBucketEncryptionInput{Bucket: bucket.Name})
+        encrypted := err == nil
+
+        public := false
+        if acl, err := svc.GetBucketAcl(ctx, &s3.GetBucketAclInput{Bucket: bucket.Name}); err == nil {
+            for _, grant := range acl.Grants {
+                uri := aws.ToString(grant.Grantee.URI)
+                if uri == "http://acs.amazonaws.com/groups/global/AllUsers" ||
+                    uri == "http://acs.amazonaws.com/groups/global/AuthenticatedUsers" {
+                    public = true
+                    break
+                }
+            }
+        }
+
+        versioningEnabled := false
+        if versioning, err := svc.GetBucketVersioning(ctx, &s3.GetBucketVersioningInput{Bucket: bucket.Name}); err == nil {
+            versioningEnabled = versioning.Status == s3types.BucketVersioningStatusEnabled
+        }
+
+        result = append(result, map[string]any{
+            "name":               bucketName,
+            "encrypted":          encrypted,
+            "public":             public,
+            "versioning_enabled": versioningEnabled,
+        })
+    }
+
+    return result, nil
+}
+
+// collectSecurityGroups mirrors the data securityGroupOpenPortsCheck and
+// defaultSecurityGroupCheck inspect: ingress rules and default-group status.
+func collectSecurityGroups(ctx context.Context, cfg aws.Config) ([]map[string]any, error) {
+    svc := ec2.NewFromConfig(cfg)
+
+    var securityGroups []ec2types.SecurityGroup
+    paginator := ec2.NewDescribeSecurityGroupsPaginator(svc, &ec2.DescribeSecurityGroupsInput{})
+    for paginator.HasMorePages() {
+        page, err := paginator.NextPage(ctx)
+        if err != nil {
+            return nil, fmt.Errorf("failed to describe security groups: %w", err)
+        }
+        securityGroups = append(securityGroups, page.SecurityGroups...)
+    }
+
+    var groups []map[string]any
+    for _, sg := range securityGroups {
+        var ingressRules []map[string]any
+        for _, permission := range sg.IpPermissions {
+            var fromPort, toPort int32
+            if permission.FromPort != nil {
+                fromPort = *permission.FromPort
+            }
+            if permission.ToPort != nil {
+                toPort = *permission.ToPort
+            }
+
+            for _, ipRange := range permission.IpRanges {
+                ingressRules = append(ingressRules, map[string]any{
+                    "from_port": fromPort,
+                    "to_port":   toPort,
+                    "cidr":      aws.ToString(ipRange.CidrIp),
+                })
+            }
+        }
+
+        groups = append(groups, map[string]any{
+            "group_id":      aws.ToString(sg.GroupId),
+            "group_name":    aws.ToString(sg.GroupName),
+            "vpc_id":        aws.ToString(sg.VpcId),
+            "is_default":    aws.ToString(sg.GroupName) == "default",
+            "ingress_rules": ingressRules,
+        })
+    }
+
+    return groups, nil
+}
+
+// listBuckets lists every bucket in the account, paging through
+// ListBuckets' ContinuationToken.
+func listBuckets(ctx context.Context, svc *s3.Client) ([]s3types.Bucket, error) {
+    var buckets []s3types.Bucket
+
+    paginator := s3.NewListBucketsPaginator(svc, &s3.ListBucketsInput{})
+    for paginator.HasMorePages() {
+        page, err := paginator.NextPage(ctx)
+        if err != nil {
+            return nil, fmt.Errorf("failed to list S3 buckets: %w", err)
+        }
+        buckets = append(buckets, page.Buckets...)
+    }
+
+    return buckets, nil
+}
+
+// daysSince returns the number of whole days elapsed since t.
+func daysSince(t time.Time) int {
+    return int(time.Since(t).Hours() / 24)
+}
@@ -0,0 +1,242 @@
+package policy
+
+import (
+    "aws-security-scanner/models"
+    "context"
+    "crypto/sha256"
+    "encoding/hex"
+    "fmt"
+    "os"
+    "path/filepath"
+    "regexp"
+    "strings"
+
+    "github.com/open-policy-agent/opa/rego"
+)
+
+/*
+Policy-as-code check engine - lets users add organization-specific rules
+(e.g. "every S3 bucket must have tag CostCenter") as .rego files dropped
+into a directory, without rebuilding the binary.
+
+Java equivalent: this is roughly a DroolsRuleEngine wrapper - rules are
+loaded from files at startup, evaluated against a shared "facts" document,
+and any rule that fires becomes a Finding.
+*/
+
+// packageNameRe extracts the declared `package <name>` from a .rego file,
+// which becomes the resulting findings' CheckID.
+var packageNameRe = regexp.MustCompile(`(?m)^\s*package\s+([\w.]+)`)
+
+// Policy is one loaded .rego file.
+type Policy struct {
+    Name   string // declared Rego package, e.g. "s3_cost_center"
+    Path   string // source file, used as the Rego module name for error messages
+    Source string // raw .rego contents
+}
+
+// LoadPolicies reads every *.rego file in dir (non-recursive) into a Policy.
+func LoadPolicies(dir string) ([]*Policy, error) {
+    entries, err := os.ReadDir(dir)
+    if err != nil {
+        return nil, fmt.Errorf("failed to read policy directory %s: %w", dir, err)
+    }
+
+    var policies []*Policy
+    for _, entry := range entries {
+        if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".rego") {
+            continue
+        }
+
+        path := filepath.Join(dir, entry.Name())
+        source, err := os.ReadFile(path)
+        if err != nil {
+            return nil, fmt.Errorf("failed to read policy file %s: %w", path, err)
+        }
+
+        match := packageNameRe.FindStringSubmatch(string(source))
+        if match == nil {
+            return nil, fmt.Errorf("policy file %s has no `package` declaration", path)
+        }
+
+        policies = append(policies, &Policy{
+            Name:   match[1],
+            Path:   path,
+            Source: string(source),
+        })
+    }
+
+    return policies, nil
+}
+
+// Engine evaluates a set of loaded policies against a normalized input
+// document.
+type Engine struct {
+    policies []*Policy
+}
+
+// NewEngine builds an Engine from already-loaded policies.
+func NewEngine(policies []*Policy) *Engine {
+    return &Engine{policies: policies}
+}
+
+// Evaluate runs every policy's `deny` and `finding` rules against input and
+// returns the combined findings. ctx cancellation aborts evaluation before
+// the next policy starts.
+func (e *Engine) Evaluate(ctx context.Context, input map[string]any) ([]*models.Finding, error) {
+    var findings []*models.Finding
+
+    for _, p := range e.policies {
+        if err := ctx.Err(); err != nil {
+            return nil, err
+        }
+
+        denyFindings, err := p.evalDeny(ctx, input)
+        if err != nil {
+            return nil, fmt.Errorf("policy %s: %w", p.Name, err)
+        }
+        findings = append(findings, denyFindings...)
+
+        findingFindings, err := p.evalFinding(ctx, input)
+        if err != nil {
+            return nil, fmt.Errorf("policy %s: %w", p.Name, err)
+        }
+        findings = append(findings, findingFindings...)
+    }
+
+    return findings, nil
+}
+
+// evalDeny evaluates the policy's `deny[msg]` set rule. Each message becomes
+// a Medium finding tagged with the policy's package name.
+//
+// Unlike `finding[{...}]`, a `deny` rule only gives us a free-text message,
+// not a structured resource - so the message itself is the only thing that
+// identifies which resource the violation is about. We use it as the
+// ResourceID (so reports and suppressions can tell one violation from
+// another) and key on its hash rather than the raw text, since messages can
+// be long and FindingKey is meant to be a short disambiguator.
+func (p *Policy) evalDeny(ctx context.Context, input map[string]any) ([]*models.Finding, error) {
+    values, err := p.evalSet(ctx, "deny", input)
+    if err != nil {
+        return nil, err
+    }
+
+    findings := make([]*models.Finding, 0, len(values))
+    for _, value := range values {
+        msg, ok := value.(string)
+        if !ok {
+            return nil, fmt.Errorf("deny rule produced a non-string message: %v", value)
+        }
+
+        finding := models.NewMediumFinding(msg, models.ResourceAccount,
+            fmt.Sprintf("Policy violation: %s", p.Name), msg)
+        finding.CheckID = p.Name
+        finding.FindingKey = hashString(msg)
+        findings = append(findings, finding)
+    }
+
+    return findings, nil
+}
+
+// hashString returns a short, stable identifier for an arbitrary string -
+// used as a FindingKey when the only thing distinguishing two violations is
+// free text too long (or unsuited) to use as the key itself.
+func hashString(s string) string {
+    sum := sha256.Sum256([]byte(s))
+    return hex.EncodeToString(sum[:])[:16]
+}
+
+// evalFinding evaluates the policy's `finding[{id, severity, title,
+// description}]` set rule, which gives the policy full control over the
+// resulting Finding's fields. An optional "key" field becomes the
+// FindingKey; without one, a hash of title+description disambiguates
+// multiple violations the rule emits for the same id.
+func (p *Policy) evalFinding(ctx context.Context, input map[string]any) ([]*models.Finding, error) {
+    values, err := p.evalSet(ctx, "finding", input)
+    if err != nil {
+        return nil, err
+    }
+
+    findings := make([]*models.Finding, 0, len(values))
+    for _, value := range values {
+        obj, ok := value.(map[string]any)
+        if !ok {
+            return nil, fmt.Errorf("finding rule produced a non-object entry: %v", value)
+        }
+
+        title := stringField(obj, "title")
+        description := stringField(obj, "description")
+
+        finding := models.NewFinding(
+            stringField(obj, "id"),
+            stringField(obj, "resource_type"),
+            severityField(obj),
+            title,
+            description,
+        )
+        finding.CheckID = p.Name
+        // A policy's finding rule can emit more than one violation for the
+        // same id (e.g. two different checks on the same bucket), so the
+        // same FindingKey treatment evalDeny uses for deny applies here:
+        // prefer an explicit "key" field, falling back to a hash of the
+        // title/description so an unkeyed rule still doesn't collide.
+        if key := stringField(obj, "key"); key != "" {
+            finding.FindingKey = key
+        } else {
+            finding.FindingKey = hashString(title + "|" + description)
+        }
+        findings = append(findings, finding)
+    }
+
+    return findings, nil
+}
+
+// evalSet evaluates `data.<package>.<rule>` and returns its members. An
+// undefined rule (a policy that only defines `deny` but not `finding`, or
+// vice versa) evaluates to an empty result, not an error.
+func (p *Policy) evalSet(ctx context.Context, rule string, input map[string]any) ([]any, error) {
+    query := rego.New(
+        rego.Query(fmt.Sprintf("data.%s.%s", p.Name, rule)),
+        rego.Module(p.Path, p.Source),
+        rego.Input(input),
+    )
+
+    resultSet, err := query.Eval(ctx)
+    if err != nil {
+        return nil, fmt.Errorf("failed to evaluate %s rule: %w", rule, err)
+    }
+    if len(resultSet) == 0 || len(resultSet[0].Expressions) == 0 {
+        return nil, nil
+    }
+
+    values, ok := resultSet[0].Expressions[0].Value.([]any)
+    if !ok {
+        return nil, fmt.Errorf("%s rule did not produce a set", rule)
+    }
+    return values, nil
+}
+
+// stringField reads a string field out of a decoded Rego object, returning
+// "" if it's missing or the wrong type.
+func stringField(obj map[string]any, key string) string {
+    s, _ := obj[key].(string)
+    return s
+}
+
+// severityField reads and normalizes the "severity" field, defaulting to
+// Medium when it's missing or not one of the known severity levels.
+func severityField(obj map[string]any) string {
+    switch strings.ToUpper(stringField(obj, "severity")) {
+    case models.SeverityCritical:
+        return models.SeverityCritical
+    case models.SeverityHigh:
+        return models.SeverityHigh
+    case models.SeverityLow:
+        return models.SeverityLow
+    case models.SeverityInfo:
+        return models.SeverityInfo
+    default:
+        return models.SeverityMedium
+    }
+}
@@ -0,0 +1,58 @@
+package scanner
+
+import (
+    "aws-security-scanner/models"
+    "aws-security-scanner/scanner/concurrency"
+    "context"
+
+    "github.com/aws/aws-sdk-go-v2/aws"
+    "golang.org/x/time/rate"
+)
+
+/*
+Per-service rate limiters, consulted before every check's AWS calls. IAM has
+a much lower TPS budget than EC2, so one shared limiter would either starve
+EC2's throughput or still get IAM throttled - each service gets its own.
+
+Java equivalent: a Guava RateLimiter per service, e.g.
+RateLimiter.create(10.0), acquired before each client call.
+*/
+
+// serviceLimiters holds a tuned rate.Limiter for services known to have a
+// notably different TPS budget than the default.
+var serviceLimiters = map[string]*rate.Limiter{
+    "iam":        rate.NewLimiter(rate.Limit(10), 5),
+    "s3":         rate.NewLimiter(rate.Limit(50), 20),
+    "ec2":        rate.NewLimiter(rate.Limit(40), 20),
+    "cloudtrail": rate.NewLimiter(rate.Limit(10), 5),
+    "kms":        rate.NewLimiter(rate.Limit(10), 5),
+}
+
+// defaultLimiter covers any service without a tuned limiter above.
+var defaultLimiter = rate.NewLimiter(rate.Limit(20), 10)
+
+// limiterForService returns the rate limiter a check's AWS calls should wait
+// on before running.
+func limiterForService(service string) *rate.Limiter {
+    if limiter, ok := serviceLimiters[service]; ok {
+        return limiter
+    }
+    return defaultLimiter
+}
+
+// runCheckThrottled runs a check behind its service's rate limiter and
+// retries throttling errors with backoff, so scanning with a large check
+// registry doesn't hammer the AWS APIs. Shared by Scanner and MultiScanner.
+func runCheckThrottled(ctx context.Context, check Check, cfg aws.Config) ([]*models.Finding, error) {
+    if err := limiterForService(check.Service()).Wait(ctx); err != nil {
+        return nil, err
+    }
+
+    var findings []*models.Finding
+    err := concurrency.RetryThrottled(ctx, func() error {
+        var runErr error
+        findings, runErr = check.Run(ctx, cfg)
+        return runErr
+    })
+    return findings, err
+}
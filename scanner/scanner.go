@@ -2,11 +2,13 @@ package scanner
 
 import (
     "aws-security-scanner/models"
+    "aws-security-scanner/scanner/concurrency"
+    "context"
     "fmt"
     "sync"
-    
-    "github.com/aws/aws-sdk-go/aws"
-    "github.com/aws/aws-sdk-go/aws/session"
+
+    "github.com/aws/aws-sdk-go-v2/aws"
+    awsconfig "github.com/aws/aws-sdk-go-v2/config"
 )
 
 /*
@@ -28,7 +30,7 @@ In Go, we use a struct to hold dependencies (like fields in a Java class)
 
 // Scanner coordinates all security checks
 type Scanner struct {
-    session  *session.Session  // AWS session (like @Autowired AWS client)
+    cfg      aws.Config        // AWS config (like @Autowired AWS client)
     region   string
     findings []*models.Finding // Slice of Finding pointers (like List<Finding>)
     mu       sync.Mutex        // Mutex for thread-safe access (like synchronized in Java)
@@ -44,142 +46,166 @@ public SecurityScanner(AWSCredentialsProvider credentials) {
 }
 */
 
-// NewScanner creates a new Scanner instance
-func NewScanner(region string) (*Scanner, error) {
-    // Create AWS session (like Spring's AWS client auto-configuration)
-    sess, err := session.NewSession(&aws.Config{
-        Region: aws.String(region),
-    })
-    
+// NewScanner creates a new Scanner instance. ctx bounds the credential
+// resolution config.LoadDefaultConfig performs (e.g. an STS call for
+// web identity federation), not the scan itself.
+func NewScanner(ctx context.Context, region string) (*Scanner, error) {
+    // Resolve the default credential chain into an aws.Config (like Spring's
+    // AWS client auto-configuration)
+    cfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(region))
+
     // Go's error handling: functions return (result, error)
     // Always check err != nil (like catching exceptions in Java)
     if err != nil {
-        return nil, fmt.Errorf("failed to create AWS session: %w", err)
+        return nil, fmt.Errorf("failed to load AWS config: %w", err)
     }
-    
+
     // Return pointer to new Scanner
     // & means "address of" - creates a pointer
     return &Scanner{
-        session:  sess,
+        cfg:      cfg,
         region:   region,
         findings: make([]*models.Finding, 0), // Create empty slice (like new ArrayList<>())
     }, nil
 }
 
 /*
-Scan runs all security checks
+selectChecks resolves the --checks/--skip-checks flags against the default
+registry. An empty include list means "everything registered".
 
 Java equivalent:
-public List<Finding> scan() {
+private List<Check> selectChecks(Set<String> include, Set<String> skip) {
+    return registry.all().stream()
+        .filter(c -> include.isEmpty() || include.contains(c.getId()))
+        .filter(c -> !skip.contains(c.getId()))
+        .collect(Collectors.toList());
+}
+*/
+
+// selectChecks filters the registry's checks by ID using the given include
+// and skip lists (either may be nil/empty).
+func selectChecks(include, skip []string) []Check {
+    includeSet := toSet(include)
+    skipSet := toSet(skip)
+
+    var selected []Check
+    for _, check := range defaultRegistry.All() {
+        if len(includeSet) > 0 && !includeSet[check.ID()] {
+            continue
+        }
+        if skipSet[check.ID()] {
+            continue
+        }
+        selected = append(selected, check)
+    }
+    return selected
+}
+
+// toSet converts a slice of check IDs into a lookup set.
+func toSet(ids []string) map[string]bool {
+    set := make(map[string]bool, len(ids))
+    for _, id := range ids {
+        set[id] = true
+    }
+    return set
+}
+
+/*
+Scan runs the selected security checks sequentially.
+
+Java equivalent:
+public List<Finding> scan(Set<String> checks, Set<String> skipChecks) {
     List<Finding> findings = new ArrayList<>();
-    findings.addAll(scanS3());
-    findings.addAll(scanEC2());
-    findings.addAll(scanIAM());
+    for (Check check : selectChecks(checks, skipChecks)) {
+        findings.addAll(check.run(context));
+    }
     return findings;
 }
 */
 
-// Scan executes all security checks
-func (s *Scanner) Scan() error {
+// Scan executes the selected checks (all registered checks if checks is
+// empty) and skips any listed in skipChecks. ctx cancellation aborts any
+// in-flight AWS API call and stops before starting the next check.
+func (s *Scanner) Scan(ctx context.Context, checks, skipChecks []string) error {
     fmt.Println("🔍 Starting AWS security scan...")
     fmt.Printf("Region: %s\n\n", s.region)
-    
-    // Run S3 checks
-    fmt.Println("Scanning S3 buckets...")
-    if err := s.scanS3(); err != nil {
-        return fmt.Errorf("S3 scan failed: %w", err)
-    }
-    
-    // Run EC2/Security Group checks
-    fmt.Println("Scanning Security Groups...")
-    if err := s.scanSecurityGroups(); err != nil {
-        return fmt.Errorf("Security Group scan failed: %w", err)
-    }
-    
-    // Run IAM checks
-    fmt.Println("Scanning IAM users...")
-    if err := s.scanIAM(); err != nil {
-        return fmt.Errorf("IAM scan failed: %w", err)
+
+    for _, check := range selectChecks(checks, skipChecks) {
+        if err := ctx.Err(); err != nil {
+            return err
+        }
+        fmt.Printf("Running %s (%s)...\n", check.Title(), check.ID())
+        if err := s.runCheck(ctx, check); err != nil {
+            return fmt.Errorf("check %s failed: %w", check.ID(), err)
+        }
     }
-    
+
     fmt.Printf("\n✅ Scan complete. Found %d issues.\n", len(s.findings))
     return nil
 }
 
 /*
-ScanConcurrent runs checks in parallel using goroutines
-
-This is Go's superpower! Goroutines are like lightweight threads.
+ScanConcurrent runs the selected checks through a bounded worker pool
+instead of one goroutine per check, so a large check registry can't open
+more AWS API calls at once than maxConcurrency allows.
 
 Java equivalent (using ExecutorService):
-ExecutorService executor = Executors.newFixedThreadPool(3);
-List<Future<List<Finding>>> futures = new ArrayList<>();
-futures.add(executor.submit(() -> scanS3()));
-futures.add(executor.submit(() -> scanEC2()));
-futures.add(executor.submit(() -> scanIAM()));
+ExecutorService executor = Executors.newFixedThreadPool(maxConcurrency);
+List<Future<?>> futures = checks.stream()
+    .map(check -> executor.submit(() -> check.run(context)))
+    .collect(Collectors.toList());
 // Wait for all and collect results...
 
-Go makes this MUCH simpler with goroutines and channels!
+concurrency.ForEachJob is our equivalent of that fixed thread pool: it caps
+how many checks run at once regardless of how many are selected.
 */
 
-// ScanConcurrent runs all checks in parallel (demonstrates goroutines)
-func (s *Scanner) ScanConcurrent() error {
+// ScanConcurrent runs the selected checks in parallel, bounded to
+// maxConcurrency workers (runtime.NumCPU() if <= 0). ctx cancellation
+// aborts in-flight AWS API calls across every worker.
+func (s *Scanner) ScanConcurrent(ctx context.Context, checks, skipChecks []string, maxConcurrency int) error {
     fmt.Println("🔍 Starting concurrent AWS security scan...")
     fmt.Printf("Region: %s\n\n", s.region)
-    
-    // WaitGroup is like CountDownLatch in Java
-    // Waits for all goroutines to complete
-    var wg sync.WaitGroup
-    
-    // Channel to collect errors (like a queue)
-    // Channels are Go's way of communicating between goroutines
-    errChan := make(chan error, 3) // Buffer of 3 (one per check)
-    
-    // Launch S3 scan in goroutine (like executor.submit())
-    wg.Add(1) // Increment counter
-    go func() {
-        defer wg.Done() // Decrement when done (like finally block)
-        fmt.Println("Scanning S3 buckets...")
-        if err := s.scanS3(); err != nil {
-            errChan <- err // Send error to channel
-        }
-    }()
-    
-    // Launch Security Group scan
-    wg.Add(1)
-    go func() {
-        defer wg.Done()
-        fmt.Println("Scanning Security Groups...")
-        if err := s.scanSecurityGroups(); err != nil {
-            errChan <- err
-        }
-    }()
-    
-    // Launch IAM scan
-    wg.Add(1)
-    go func() {
-        defer wg.Done()
-        fmt.Println("Scanning IAM users...")
-        if err := s.scanIAM(); err != nil {
-            errChan <- err
-        }
-    }()
-    
-    // Wait for all goroutines to complete
-    wg.Wait()
-    close(errChan) // Close channel when done
-    
-    // Check if any errors occurred
-    for err := range errChan {
-        if err != nil {
-            return err
-        }
+
+    selected := selectChecks(checks, skipChecks)
+
+    checksByID := make(map[string]Check, len(selected))
+    jobs := make([]concurrency.Job, 0, len(selected))
+    for _, check := range selected {
+        checksByID[check.ID()] = check
+        jobs = append(jobs, concurrency.Job{CheckID: check.ID(), Region: s.region})
     }
-    
+
+    err := concurrency.ForEachJob(ctx, jobs, maxConcurrency, func(ctx context.Context, job concurrency.Job) error {
+        check := checksByID[job.CheckID]
+        fmt.Printf("Running %s (%s)...\n", check.Title(), check.ID())
+        return s.runCheck(ctx, check)
+    })
+    if err != nil {
+        return err
+    }
+
     fmt.Printf("\n✅ Concurrent scan complete. Found %d issues.\n", len(s.findings))
     return nil
 }
 
+// runCheck executes a single check - behind its service's rate limiter and
+// an adaptive retrier for throttling errors - and records its findings,
+// tagging each with the check's ID so findings can be mapped back to a
+// benchmark control.
+func (s *Scanner) runCheck(ctx context.Context, check Check) error {
+    findings, err := runCheckThrottled(ctx, check, s.cfg)
+    if err != nil {
+        return err
+    }
+
+    for _, finding := range findings {
+        finding.CheckID = check.ID()
+        s.addFinding(finding)
+    }
+    return nil
+}
+
 /*
 GetFindings returns all findings
 
@@ -196,6 +222,12 @@ func (s *Scanner) GetFindings() []*models.Finding {
     return s.findings
 }
 
+// GetConfig returns the underlying AWS config so reporters (e.g. the ASFF
+// reporter) can make their own AWS API calls.
+func (s *Scanner) GetConfig() aws.Config {
+    return s.cfg
+}
+
 /*
 addFinding adds a finding to the list (thread-safe)
 
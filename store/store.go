@@ -0,0 +1,162 @@
+package store
+
+import (
+    "aws-security-scanner/models"
+    "encoding/json"
+    "fmt"
+    "time"
+
+    bolt "go.etcd.io/bbolt"
+)
+
+/*
+Store persists findings across scan runs so the scanner can tell "3 new
+findings since yesterday" instead of producing a standalone snapshot every
+time - the CI/CD use case.
+
+Java equivalent:
+@Repository
+public class FindingStore {
+    private final DB db; // embedded key-value store
+    public List<Finding> diff(List<Finding> current) { ... }
+}
+*/
+
+// findingsBucket is the single bbolt bucket findings are persisted under,
+// keyed by Finding.Fingerprint().
+var findingsBucket = []byte("findings")
+
+// record is what's actually persisted per fingerprint: the finding as it
+// looked on the run that last saw it, plus when it was first/last observed.
+type record struct {
+    Finding   models.Finding `json:"finding"`
+    FirstSeen time.Time      `json:"first_seen"`
+    LastSeen  time.Time      `json:"last_seen"`
+}
+
+// Store is a persistent, on-disk baseline of findings from previous scans.
+type Store struct {
+    db *bolt.DB
+}
+
+// NewStore opens (or creates) the bbolt database at path.
+func NewStore(path string) (*Store, error) {
+    db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: 5 * time.Second})
+    if err != nil {
+        return nil, fmt.Errorf("failed to open baseline store %s: %w", path, err)
+    }
+
+    err = db.Update(func(tx *bolt.Tx) error {
+        _, err := tx.CreateBucketIfNotExists(findingsBucket)
+        return err
+    })
+    if err != nil {
+        db.Close()
+        return nil, fmt.Errorf("failed to initialize baseline store %s: %w", path, err)
+    }
+
+    return &Store{db: db}, nil
+}
+
+// Close releases the underlying database file.
+func (s *Store) Close() error {
+    return s.db.Close()
+}
+
+/*
+Diff compares findings (the current run's results) against the previous
+run persisted in the store, stamping each finding's FirstSeen/LastSeen/
+Status in place, then overwrites the store with findings so the next run
+diffs against this one. It returns the findings from the previous run that
+didn't reappear in this one, tagged models.StatusResolved.
+
+Java equivalent:
+public List<Finding> diff(List<Finding> current) {
+    Map<String, Record> previous = loadAll();
+    List<Finding> resolved = new ArrayList<>();
+    for (Finding f : current) { ... }
+    for (Record r : previous.values()) if (not seen) resolved.add(r.finding);
+    saveAll(current);
+    return resolved;
+}
+*/
+
+// Diff stamps findings with FirstSeen/LastSeen/Status relative to the
+// store's previous contents, persists findings as the new baseline, and
+// returns the previously-seen findings that are no longer present.
+func (s *Store) Diff(findings []*models.Finding) ([]*models.Finding, error) {
+    now := time.Now()
+    var resolved []*models.Finding
+
+    err := s.db.Update(func(tx *bolt.Tx) error {
+        bucket := tx.Bucket(findingsBucket)
+
+        previous := make(map[string]record)
+        if err := bucket.ForEach(func(k, v []byte) error {
+            var rec record
+            if err := json.Unmarshal(v, &rec); err != nil {
+                return fmt.Errorf("failed to decode baseline record %s: %w", k, err)
+            }
+            previous[string(k)] = rec
+            return nil
+        }); err != nil {
+            return err
+        }
+
+        seen := make(map[string]*models.Finding, len(findings))
+        for _, finding := range findings {
+            fp := finding.Fingerprint()
+            if other, ok := seen[fp]; ok {
+                return fmt.Errorf("duplicate finding fingerprint %s: %q (check %s, resource %s) collides "+
+                    "with %q (check %s, resource %s) - give one of them a distinct FindingKey",
+                    fp, finding.Title, finding.CheckID, finding.ResourceID,
+                    other.Title, other.CheckID, other.ResourceID)
+            }
+            seen[fp] = finding
+
+            if prev, ok := previous[fp]; ok {
+                finding.FirstSeen = prev.FirstSeen
+                finding.Status = models.StatusUnchanged
+            } else {
+                finding.FirstSeen = now
+                finding.Status = models.StatusNew
+            }
+            finding.LastSeen = now
+        }
+
+        for fp, prev := range previous {
+            if _, ok := seen[fp]; ok {
+                continue
+            }
+            resolvedFinding := prev.Finding
+            resolvedFinding.Status = models.StatusResolved
+            resolved = append(resolved, &resolvedFinding)
+        }
+
+        if err := tx.DeleteBucket(findingsBucket); err != nil {
+            return fmt.Errorf("failed to clear baseline store: %w", err)
+        }
+        newBucket, err := tx.CreateBucket(findingsBucket)
+        if err != nil {
+            return fmt.Errorf("failed to recreate baseline store: %w", err)
+        }
+
+        for _, finding := range findings {
+            rec := record{Finding: *finding, FirstSeen: finding.FirstSeen, LastSeen: finding.LastSeen}
+            data, err := json.Marshal(rec)
+            if err != nil {
+                return fmt.Errorf("failed to encode baseline record: %w", err)
+            }
+            if err := newBucket.Put([]byte(finding.Fingerprint()), data); err != nil {
+                return err
+            }
+        }
+
+        return nil
+    })
+    if err != nil {
+        return nil, err
+    }
+
+    return resolved, nil
+}
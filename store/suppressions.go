@@ -0,0 +1,91 @@
+package store
+
+import (
+    "aws-security-scanner/models"
+    "fmt"
+    "os"
+    "time"
+
+    "gopkg.in/yaml.v3"
+)
+
+/*
+suppressionsFileYAML mirrors the --suppress-file YAML format:
+
+  suppressions:
+    - fingerprint: "3f9c2b1a..."
+      justification: "Accepted risk - tracked in JIRA-1234"
+      expiry: "2026-12-31"
+*/
+
+type suppressionsFileYAML struct {
+    Suppressions []struct {
+        Fingerprint   string `yaml:"fingerprint"`
+        Justification string `yaml:"justification"`
+        Expiry        string `yaml:"expiry"` // YYYY-MM-DD
+    } `yaml:"suppressions"`
+}
+
+// Suppression is one accepted-risk entry: a finding fingerprint (see
+// models.Finding.Fingerprint) that should be hidden from reports until it
+// expires.
+type Suppression struct {
+    Fingerprint   string
+    Justification string
+    Expiry        time.Time
+}
+
+// LoadSuppressions reads a --suppress-file YAML document.
+func LoadSuppressions(path string) ([]Suppression, error) {
+    data, err := os.ReadFile(path)
+    if err != nil {
+        return nil, fmt.Errorf("failed to read suppressions file: %w", err)
+    }
+
+    var parsed suppressionsFileYAML
+    if err := yaml.Unmarshal(data, &parsed); err != nil {
+        return nil, fmt.Errorf("failed to parse suppressions file: %w", err)
+    }
+
+    suppressions := make([]Suppression, 0, len(parsed.Suppressions))
+    for _, s := range parsed.Suppressions {
+        expiry, err := time.Parse("2006-01-02", s.Expiry)
+        if err != nil {
+            return nil, fmt.Errorf("suppression for %s has invalid expiry %q (want YYYY-MM-DD): %w",
+                s.Fingerprint, s.Expiry, err)
+        }
+
+        suppressions = append(suppressions, Suppression{
+            Fingerprint:   s.Fingerprint,
+            Justification: s.Justification,
+            Expiry:        expiry,
+        })
+    }
+
+    return suppressions, nil
+}
+
+// FilterSuppressed removes findings matching an unexpired suppression.
+func FilterSuppressed(findings []*models.Finding, suppressions []Suppression) []*models.Finding {
+    active := make(map[string]bool, len(suppressions))
+    now := time.Now()
+    for _, s := range suppressions {
+        if now.Before(s.Expiry) {
+            active[s.Fingerprint] = true
+        }
+    }
+
+    if len(active) == 0 {
+        return findings
+    }
+
+    filtered := make([]*models.Finding, 0, len(findings))
+    for _, finding := range findings {
+        if active[finding.Fingerprint()] {
+            continue
+        }
+        filtered = append(filtered, finding)
+    }
+
+    return filtered
+}